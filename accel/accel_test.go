@@ -1,12 +1,20 @@
 package accel_test
 
 import (
+	"bytes"
+	"fmt"
 	"goraytracer/accel"
+	"goraytracer/assets"
 	"goraytracer/geometry"
 	"goraytracer/material"
 	"goraytracer/mesh"
 	"goraytracer/ray"
+	"goraytracer/transform"
 	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"reflect"
+	"runtime"
 	"testing"
 )
 
@@ -16,7 +24,7 @@ func assertEqual(t *testing.T, got interface{}, expected interface{}, message st
 	}
 }
 
-func TestNonIntersectedNodesReturnZeroCandidates(t *testing.T) {
+func TestNonIntersectedNodesHaveNoHit(t *testing.T) {
 	// a non-intersected node returns nil, with our without children
 	aabb := geometry.AABB{
 		Min: vec3.Vec3{X: 10, Y: 10, Z: 10},
@@ -50,8 +58,12 @@ func TestNonIntersectedNodesReturnZeroCandidates(t *testing.T) {
 		Depth:               1,
 	}
 
-	assertEqual(t, len(nodeWithChildren.Search(cameraRay)), 0, "A non-intersected node without children returns nil")
-	assertEqual(t, len(nodeWithoutChildren.Search(cameraRay)), 0, "A non-intersected node without children returns nil")
+	for _, node := range []accel.OctTreeNode{nodeWithChildren, nodeWithoutChildren} {
+		tree := accel.OctTree{RootNode: node}
+		hitRecord, _ := tree.ClosestHit(cameraRay, 0, math.Inf(1))
+		assertEqual(t, hitRecord.Hit, false, "A non-intersected node has no hit")
+		assertEqual(t, tree.Occluded(cameraRay, 0, math.Inf(1)), false, "A non-intersected node occludes nothing")
+	}
 }
 
 func countAllCandidates(node accel.OctTreeNode) int {
@@ -68,7 +80,7 @@ func countAllCandidates(node accel.OctTreeNode) int {
 	return sum
 }
 
-func TestIntersectedNodeReturnsUniqueCandidates(t *testing.T) {
+func TestIntersectedNodeReturnsClosestHit(t *testing.T) {
 	candidateX := geometry.Sphere{
 		Id:     0,
 		Center: vec3.Vec3{X: .2, Y: .5, Z: .3},
@@ -88,9 +100,36 @@ func TestIntersectedNodeReturnsUniqueCandidates(t *testing.T) {
 		vec3.Vec3{X: .2, Z: .3},
 		vec3.Vec3{X: 0, Y: 1, Z: 0}.Normalized())
 
-	candidates := tree.Search(cameraRay)
+	hitRecord, material := tree.ClosestHit(cameraRay, 0, math.Inf(1))
+
+	assertEqual(t, hitRecord.Hit, true, "Intersected nodes hit their candidate")
+	assertEqual(t, math.Abs(hitRecord.Distance-.4) < 1e-9, true, "Intersected nodes return the nearest hit")
+	assertEqual(t, material, meshes[0].Material, "Intersected nodes return the candidate's material")
+}
+
+// the triangles of a mesh share its id, so traversal mustn't tell them apart by it
+func TestMeshTrianglesAreSeparateCandidates(t *testing.T) {
+	// the farther triangle comes first
+	positions := []vec3.Vec3{
+		{X: 0, Y: 0, Z: 2}, {X: 1, Y: 0, Z: 2}, {X: 0, Y: 1, Z: 2},
+		{X: 0, Y: 0, Z: 1}, {X: 1, Y: 0, Z: 1}, {X: 0, Y: 1, Z: 1},
+	}
+	triangles, err := geometry.NewTriangleMesh(7, positions, []uint32{0, 1, 2, 3, 4, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meshes := []mesh.Mesh{{Geometry: triangles, Material: &material.Lambertian{}}}
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+	assertEqual(t, len(bvh.Candidates), 2, "every triangle is a candidate")
 
-	assertEqual(t, len(candidates), 1, "Intersected nodes return unique candidates")
+	r := ray.New(vec3.Vec3{X: .2, Y: .2}, vec3.Vec3{Z: 1})
+	for name, scene := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		hitRecord, _ := scene.ClosestHit(r, 0, math.Inf(1))
+		assertEqual(t, hitRecord.Hit, true, name+" hits the mesh")
+		assertEqual(t, hitRecord.Distance, 1., name+" returns the nearer triangle")
+		assertEqual(t, scene.Occluded(r, 1.5, math.Inf(1)), true, name+" is occluded by the farther triangle")
+	}
 }
 
 func TestSplitAABB(t *testing.T) {
@@ -139,3 +178,374 @@ func TestSplitAABB(t *testing.T) {
 	assertEqual(t, children[7].Min, vec3.Vec3{X: 0, Y: 0, Z: -1}, "child 7 min")
 	assertEqual(t, children[7].Max, vec3.Vec3{X: 1, Y: 1, Z: 0}, "child 7 max")
 }
+
+func closestDistance(candidates []accel.IntersectCandidate, r *ray.Ray) float64 {
+	closest := math.Inf(1)
+	for _, candidate := range candidates {
+		hitRecord := candidate.Geometry.Hit(r, .001, closest)
+		if hitRecord.Hit {
+			closest = hitRecord.Distance
+		}
+	}
+	return closest
+}
+
+func bunnyScene(tb testing.TB) []mesh.Mesh {
+	tb.Helper()
+
+	bunny, err := assets.BunnyPolygon()
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return []mesh.Mesh{
+		{
+			Geometry: bunny,
+			Material: &material.Lambertian{},
+		},
+		{
+			Geometry: geometry.Sphere{Id: 1, Center: vec3.Vec3{X: .6, Y: .2, Z: 0}, Radius: .2},
+			Material: &material.Lambertian{},
+		},
+	}
+}
+
+// rays from in front of the bunny towards random points within its bounds
+func bunnyRays(count int) []*ray.Ray {
+	random := rand.New(rand.NewSource(0))
+	origin := vec3.Vec3{X: 0, Y: .5, Z: 3}
+	rays := make([]*ray.Ray, count)
+	for i := range rays {
+		target := vec3.Vec3{
+			X: random.Float64() - .5,
+			Y: random.Float64(),
+			Z: random.Float64() - .5,
+		}
+		rays[i] = ray.New(origin, vec3.Sub(target, origin).Normalized())
+	}
+	return rays
+}
+
+func TestBVHReferencesEveryPrimitiveOnce(t *testing.T) {
+	bvh := accel.BuildBVH(bunnyScene(t))
+
+	seen := make([]int, len(bvh.Candidates))
+	for _, node := range bvh.Tree.Nodes {
+		if node.IsLeaf() {
+			for i := node.First; i < node.First+node.Count; i++ {
+				seen[i]++
+			}
+		}
+	}
+
+	for i, count := range seen {
+		if count != 1 {
+			t.Fatalf("candidate %d referenced by %d leaves", i, count)
+		}
+	}
+}
+
+func TestClosestHitMatchesBruteForce(t *testing.T) {
+	meshes := bunnyScene(t)
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+
+	var all []accel.IntersectCandidate
+	for _, m := range meshes {
+		for _, g := range m.Geometry.AABBIntersections(m.Geometry.BoundingBox()) {
+			all = append(all, accel.IntersectCandidate{Geometry: g, Material: m.Material})
+		}
+	}
+
+	for name, scene := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		for i, r := range bunnyRays(500) {
+			expected := closestDistance(all, r)
+			hitRecord, material := scene.ClosestHit(r, .001, math.Inf(1))
+
+			if math.IsInf(expected, 1) {
+				assertEqual(t, hitRecord.Hit, false, fmt.Sprintf("%s miss for ray %d", name, i))
+				continue
+			}
+
+			assertEqual(t, hitRecord.Hit, true, fmt.Sprintf("%s hit for ray %d", name, i))
+			assertEqual(t, hitRecord.Distance, expected, fmt.Sprintf("%s closest distance for ray %d", name, i))
+			if material == nil {
+				t.Errorf("%s returned no material for ray %d", name, i)
+			}
+		}
+	}
+}
+
+func TestOccludedAgreesWithClosestHit(t *testing.T) {
+	meshes := bunnyScene(t)
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+
+	for name, scene := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		for i, r := range bunnyRays(500) {
+			hitRecord, _ := scene.ClosestHit(r, .001, math.Inf(1))
+			assertEqual(t, scene.Occluded(r, .001, math.Inf(1)), hitRecord.Hit, fmt.Sprintf("%s occluded for ray %d", name, i))
+
+			if hitRecord.Hit {
+				// nothing can be in front of the closest hit
+				assertEqual(t, scene.Occluded(r, .001, hitRecord.Distance*.999), false, fmt.Sprintf("%s occluded before closest hit for ray %d", name, i))
+			}
+		}
+	}
+}
+
+func TestEmptyScene(t *testing.T) {
+	tree := accel.BuildOctTree(nil)
+	bvh := accel.BuildBVH(nil)
+	r := ray.New(vec3.Vec3{}, vec3.Vec3{Z: 1})
+
+	for name, scene := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		hitRecord, _ := scene.ClosestHit(r, .001, math.Inf(1))
+		assertEqual(t, hitRecord.Hit, false, name+" empty scene closest hit")
+		assertEqual(t, scene.Occluded(r, .001, math.Inf(1)), false, name+" empty scene occluded")
+	}
+}
+
+func TestOctTreeBoundsEncloseScene(t *testing.T) {
+	// this sphere used to poke out of the fixed -100..100 world
+	meshes := []mesh.Mesh{
+		{
+			Geometry: geometry.Sphere{Id: 0, Center: vec3.Vec3{X: -151}, Radius: 50},
+			Material: &material.Lambertian{},
+		},
+		{
+			Geometry: geometry.Sphere{Id: 1, Center: vec3.Vec3{X: 20, Z: -5}, Radius: 2},
+			Material: &material.Lambertian{},
+		},
+	}
+
+	tree := accel.BuildOctTree(meshes)
+	assertEqual(t, tree.RootNode.Aabb, geometry.AABB{
+		Min: vec3.Vec3{X: -201, Y: -50, Z: -50},
+		Max: vec3.Vec3{X: 22, Y: 50, Z: 50},
+	}, "root bounds are the union of mesh bounds")
+
+	hitRecord, _ := tree.ClosestHit(ray.New(vec3.Vec3{X: -190, Z: 100}, vec3.Vec3{Z: -1}), .001, math.Inf(1))
+	assertEqual(t, hitRecord.Hit, true, "geometry far from the origin is hit")
+}
+
+func TestUnboundedGeometry(t *testing.T) {
+	meshes := []mesh.Mesh{
+		{
+			Geometry: geometry.Plane{Id: 0, Point: vec3.Vec3{Y: -1}, Normal: vec3.Vec3{Y: 1}},
+			Material: &material.Lambertian{},
+		},
+		{
+			Geometry: geometry.Sphere{Id: 1, Center: vec3.Vec3{}, Radius: .5},
+			Material: &material.Lambertian{},
+		},
+	}
+
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+	assertEqual(t, len(tree.Unbounded), 1, "plane is kept out of the octree")
+	assertEqual(t, len(bvh.Unbounded), 1, "plane is kept out of the bvh")
+
+	// aimed at the plane far outside the sphere's bounds
+	r := ray.New(vec3.Vec3{X: 1000, Y: 10, Z: 1000}, vec3.Vec3{Y: -1})
+
+	for name, scene := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		hitRecord, _ := scene.ClosestHit(r, .001, math.Inf(1))
+		assertEqual(t, hitRecord.Hit, true, name+" plane hit")
+		assertEqual(t, hitRecord.Distance, 11.0, name+" plane distance")
+		assertEqual(t, scene.Occluded(r, .001, math.Inf(1)), true, name+" plane occludes")
+		assertEqual(t, scene.Occluded(r, .001, 10), false, name+" plane beyond max distance")
+	}
+}
+
+func TestBuildIsDeterministic(t *testing.T) {
+	meshes := bunnyScene(t)
+
+	previous := runtime.GOMAXPROCS(1)
+	defer runtime.GOMAXPROCS(previous)
+
+	serialTree := accel.BuildOctTree(meshes)
+	serialBVH := accel.BuildBVH(meshes)
+
+	runtime.GOMAXPROCS(8)
+
+	for i := 0; i < 3; i++ {
+		if tree := accel.BuildOctTree(meshes); !reflect.DeepEqual(tree, serialTree) {
+			t.Fatal("octree differs between serial and parallel builds")
+		}
+		if bvh := accel.BuildBVH(meshes); !reflect.DeepEqual(bvh, serialBVH) {
+			t.Fatal("bvh differs between serial and parallel builds")
+		}
+	}
+}
+
+func TestStats(t *testing.T) {
+	meshes := bunnyScene(t)
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+
+	bvhStats := bvh.Stats()
+	assertEqual(t, bvhStats.Nodes, len(bvh.Tree.Nodes), "bvh node count")
+	assertEqual(t, bvhStats.Primitives, len(bvh.Candidates), "every bvh primitive is in exactly one leaf")
+	assertEqual(t, bvhStats.EmptyLeaves, 0, "bvh has no empty leaves")
+	assertEqual(t, bvhStats.Nodes, 2*bvhStats.Leaves-1, "bvh is a binary tree")
+
+	treeStats := tree.Stats()
+	if treeStats.Primitives < len(bvh.Candidates) {
+		t.Errorf("octree references %d primitives, expected at least %d", treeStats.Primitives, len(bvh.Candidates))
+	}
+	if treeStats.MaxPrimitivesPerLeaf < 1 || treeStats.AveragePrimitivesPerLeaf > float64(treeStats.MaxPrimitivesPerLeaf) {
+		t.Errorf("inconsistent leaf occupancy %v", treeStats)
+	}
+}
+
+func TestTraversalCost(t *testing.T) {
+	meshes := bunnyScene(t)
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+
+	miss := ray.New(vec3.Vec3{Z: 3}, vec3.Vec3{Z: 1})
+
+	for name, scene := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		assertEqual(t, scene.TraversalCost(miss, .001, math.Inf(1)), accel.TraversalStats{}, name+" ray missing the scene costs nothing")
+
+		for i, r := range bunnyRays(50) {
+			hitRecord, _ := scene.ClosestHit(r, .001, math.Inf(1))
+			stats := scene.TraversalCost(r, .001, math.Inf(1))
+			if hitRecord.Hit && (stats.NodesVisited == 0 || stats.PrimitivesTested == 0) {
+				t.Errorf("%s ray %d hit without any recorded work: %+v", name, i, stats)
+			}
+		}
+	}
+}
+
+func TestInstancedBunnies(t *testing.T) {
+	bunny, err := assets.BunnyPolygon()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meshes := make([]mesh.Mesh, 10)
+	for i := range meshes {
+		placement := transform.Compose(
+			transform.RotateY(float64(i)),
+			transform.Translate(vec3.Vec3{X: float64(i%5) - 2, Z: -float64(i / 5)}),
+		)
+		instance, _ := geometry.NewInstance(uint32(i), bunny, placement)
+		meshes[i] = mesh.Mesh{Geometry: instance, Material: &material.Lambertian{}}
+	}
+
+	bvh := accel.BuildBVH(meshes)
+	assertEqual(t, len(bvh.Candidates), 10, "the top level holds one primitive per instance")
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		origin := vec3.Vec3{X: random.Float64()*6 - 3, Y: .5, Z: 4}
+		target := vec3.Vec3{X: random.Float64()*6 - 3, Y: random.Float64(), Z: -random.Float64() * 2}
+		r := ray.New(origin, vec3.Sub(target, origin).Normalized())
+
+		expected := math.Inf(1)
+		for _, m := range meshes {
+			if hit := m.Geometry.Hit(r, .001, expected); hit.Hit {
+				expected = hit.Distance
+			}
+		}
+
+		hitRecord, _ := bvh.ClosestHit(r, .001, math.Inf(1))
+		if math.IsInf(expected, 1) {
+			assertEqual(t, hitRecord.Hit, false, fmt.Sprintf("miss for ray %d", i))
+		} else {
+			assertEqual(t, hitRecord.Distance, expected, fmt.Sprintf("closest instance for ray %d", i))
+		}
+	}
+}
+
+func TestSmoothBunnyMeshMatchesPolygon(t *testing.T) {
+	smooth, err := assets.Bunny()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flat := accel.BuildBVH(bunnyScene(t))
+	meshes := bunnyScene(t)
+	meshes[0].Geometry = smooth
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+	assertEqual(t, len(bvh.Candidates), len(flat.Candidates), "a mesh is split into its triangles")
+
+	var buffer bytes.Buffer
+	if err := accel.WriteCache(&buffer, &bvh, meshes); err != nil {
+		t.Fatal(err)
+	}
+	cached, err := accel.ReadCache(&buffer, meshes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, r := range bunnyRays(500) {
+		expected, _ := flat.ClosestHit(r, .001, math.Inf(1))
+		for name, scene := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh, "cached bvh": cached} {
+			got, _ := scene.ClosestHit(r, .001, math.Inf(1))
+			assertEqual(t, got.Distance, expected.Distance, fmt.Sprintf("%s distance for ray %d", name, i))
+			assertEqual(t, got.GeometricNormal, expected.GeometricNormal, fmt.Sprintf("%s face for ray %d", name, i))
+		}
+	}
+}
+
+// runs a build benchmark on one core and on all of them
+func benchmarkBuild(b *testing.B, build func(meshes []mesh.Mesh)) {
+	meshes := bunnyScene(b)
+
+	procsList := []int{1}
+	if runtime.NumCPU() > 1 {
+		procsList = append(procsList, runtime.NumCPU())
+	}
+
+	for _, procs := range procsList {
+		b.Run(fmt.Sprintf("procs=%d", procs), func(b *testing.B) {
+			previous := runtime.GOMAXPROCS(procs)
+			defer runtime.GOMAXPROCS(previous)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				build(meshes)
+			}
+		})
+	}
+}
+
+func BenchmarkBuildOctTreeBunny(b *testing.B) {
+	benchmarkBuild(b, func(meshes []mesh.Mesh) { accel.BuildOctTree(meshes) })
+}
+
+func BenchmarkBuildBVHBunny(b *testing.B) {
+	benchmarkBuild(b, func(meshes []mesh.Mesh) { accel.BuildBVH(meshes) })
+}
+
+func benchmarkTraversal(b *testing.B, scene accel.Accelerator) {
+	rays := bunnyRays(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r := rays[i%len(rays)]
+		scene.ClosestHit(r, .001, math.Inf(1))
+	}
+}
+
+func BenchmarkTraverseOctTreeBunny(b *testing.B) {
+	tree := accel.BuildOctTree(bunnyScene(b))
+	benchmarkTraversal(b, &tree)
+}
+
+func BenchmarkTraverseBVHBunny(b *testing.B) {
+	bvh := accel.BuildBVH(bunnyScene(b))
+	benchmarkTraversal(b, &bvh)
+}
+
+func BenchmarkOccludedBVHBunny(b *testing.B) {
+	bvh := accel.BuildBVH(bunnyScene(b))
+	rays := bunnyRays(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bvh.Occluded(rays[i%len(rays)], .001, math.Inf(1))
+	}
+}
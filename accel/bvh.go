@@ -0,0 +1,94 @@
+package accel
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/mesh"
+	"goraytracer/ray"
+	"unsafe"
+)
+
+type BVH struct {
+	Tree geometry.BVH
+
+	// candidates in the same order as Tree.Indices, so leaves can slice into it directly
+	Candidates []IntersectCandidate
+
+	// geometry without finite bounds, tested against every ray
+	Unbounded []IntersectCandidate
+}
+
+func (bvh *BVH) closestHit(ray *ray.Ray, minDistance float64, closest *closestHit) {
+	closest.test(bvh.Unbounded, ray, minDistance)
+
+	var nodesVisited *int
+	if closest.stats != nil {
+		nodesVisited = &closest.stats.NodesVisited
+	}
+
+	bvh.Tree.Traverse(ray, minDistance, closest.maxDistance, nodesVisited, func(leaf *geometry.BVHNode) float64 {
+		closest.test(bvh.Candidates[leaf.First:leaf.First+leaf.Count], ray, minDistance)
+		return closest.maxDistance
+	})
+}
+
+func (bvh *BVH) ClosestHit(ray *ray.Ray, minDistance float64, maxDistance float64) (geometry.HitRecord, material.Material) {
+	closest := closestHit{maxDistance: maxDistance}
+	bvh.closestHit(ray, minDistance, &closest)
+	return closest.record, closest.material
+}
+
+func (bvh *BVH) TraversalCost(ray *ray.Ray, minDistance float64, maxDistance float64) TraversalStats {
+	stats := TraversalStats{}
+	bvh.closestHit(ray, minDistance, &closestHit{maxDistance: maxDistance, stats: &stats})
+	return stats
+}
+
+func (bvh *BVH) Stats() Stats {
+	stats := Stats{
+		Nodes:     len(bvh.Tree.Nodes),
+		Unbounded: len(bvh.Unbounded),
+		MemoryBytes: len(bvh.Tree.Nodes)*int(unsafe.Sizeof(geometry.BVHNode{})) +
+			len(bvh.Tree.Indices)*int(unsafe.Sizeof(int(0))) +
+			(len(bvh.Candidates)+len(bvh.Unbounded))*int(unsafe.Sizeof(IntersectCandidate{})),
+	}
+
+	for _, node := range bvh.Tree.Nodes {
+		if node.IsLeaf() {
+			stats.addLeaf(node.Count)
+		}
+	}
+
+	return stats.finish()
+}
+
+func (bvh *BVH) Occluded(ray *ray.Ray, minDistance float64, maxDistance float64) bool {
+	if anyOccludes(bvh.Unbounded, ray, minDistance, maxDistance) {
+		return true
+	}
+
+	return bvh.Tree.TraverseAny(ray, minDistance, maxDistance, func(leaf *geometry.BVHNode) bool {
+		return anyOccludes(bvh.Candidates[leaf.First:leaf.First+leaf.Count], ray, minDistance, maxDistance)
+	})
+}
+
+func BuildBVH(meshes []mesh.Mesh) BVH {
+	bounded, unbounded := splitUnbounded(collectCandidates(meshes))
+
+	bounds := make([]geometry.AABB, len(bounded))
+	for i, candidate := range bounded {
+		bounds[i] = candidate.Geometry.BoundingBox()
+	}
+
+	return newBVH(geometry.BuildBVH(bounds), bounded, unbounded)
+}
+
+// newBVH orders the bounded candidates to match the tree's leaves
+func newBVH(tree geometry.BVH, bounded []IntersectCandidate, unbounded []IntersectCandidate) BVH {
+	ordered := make([]IntersectCandidate, len(bounded))
+	for i, index := range tree.Indices {
+		ordered[i] = bounded[index]
+	}
+
+	return BVH{Tree: tree, Candidates: ordered, Unbounded: unbounded}
+}
@@ -0,0 +1,421 @@
+package accel
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"goraytracer/geometry"
+	"goraytracer/mesh"
+	"io"
+	"os"
+)
+
+// Built accelerators can be cached on disk and loaded back against the same scene.
+// Geometry and materials are not stored: primitives are referenced by their position in the
+// scene's enumeration (see collectCandidates) and resolved against the meshes on load.
+//
+// Layout, little endian:
+//   magic "GRAC", version uint32, kind uint8, scene hash [32]byte
+//   bvh:    node count uint32, nodes (aabb, right, first, count), index count uint32, indices
+//   octree: nodes in depth first order (aabb, depth, child count, candidate count, candidate indices)
+
+const CacheVersion = 1
+
+var cacheMagic = [4]byte{'G', 'R', 'A', 'C'}
+
+const (
+	cacheKindOctTree uint8 = 1
+	cacheKindBVH     uint8 = 2
+)
+
+var (
+	// ErrCacheVersion is returned when a cache was written by a different format version.
+	ErrCacheVersion = errors.New("accel: unsupported cache version")
+
+	// ErrSceneMismatch is returned when a cache was built for different geometry.
+	ErrSceneMismatch = errors.New("accel: cache was built for a different scene")
+)
+
+// SceneHash returns a content hash of the geometry in meshes.
+// Materials are not part of the hash since they don't affect the acceleration structure.
+func SceneHash(meshes []mesh.Mesh) ([32]byte, error) {
+	hash := sha256.New()
+	writer := cacheWriter{w: hash}
+
+	writer.write(uint32(len(meshes)))
+	for _, mesh := range meshes {
+		primitives := mesh.Geometry.AABBIntersections(mesh.Geometry.BoundingBox())
+		writer.write(uint32(len(primitives)))
+
+		for _, primitive := range primitives {
+			if err := hashGeometry(&writer, primitive); err != nil {
+				return [32]byte{}, err
+			}
+		}
+	}
+
+	var sum [32]byte
+	copy(sum[:], hash.Sum(nil))
+	return sum, writer.err
+}
+
+func hashGeometry(writer *cacheWriter, g geometry.Geometry) error {
+	switch primitive := g.(type) {
+	case geometry.Triangle:
+		writer.write(uint8(1))
+		writer.write(primitive.P1)
+		writer.write(primitive.P2)
+		writer.write(primitive.P3)
+	case geometry.Sphere:
+		writer.write(uint8(2))
+		writer.write(primitive.Center)
+		writer.write(primitive.Radius)
+	case *geometry.Sphere:
+		writer.write(uint8(2))
+		writer.write(primitive.Center)
+		writer.write(primitive.Radius)
+	case geometry.Plane:
+		writer.write(uint8(3))
+		writer.write(primitive.Point)
+		writer.write(primitive.Normal)
+	case geometry.Polygon:
+		writer.write(uint8(4))
+		writer.write(uint32(len(primitive.Triangles)))
+		for _, triangle := range primitive.Triangles {
+			if err := hashGeometry(writer, triangle); err != nil {
+				return err
+			}
+		}
+	case geometry.Instance:
+		writer.write(uint8(5))
+		writer.write(primitive.Transform())
+		return hashGeometry(writer, primitive.Geometry)
+	case geometry.MeshTriangle:
+		p1, p2, p3 := primitive.Positions()
+		writer.write(uint8(6))
+		writer.write(p1)
+		writer.write(p2)
+		writer.write(p3)
+	case *geometry.TriangleMesh:
+		writer.write(uint8(7))
+		writer.write(uint32(len(primitive.Positions)))
+		writer.write(primitive.Positions)
+		writer.write(uint32(len(primitive.Indices)))
+		writer.write(primitive.Indices)
+	default:
+		return fmt.Errorf("accel: cannot hash geometry of type %T", g)
+	}
+	return nil
+}
+
+// cacheWriter writes fixed size values and keeps the first error
+type cacheWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (writer *cacheWriter) write(value interface{}) {
+	if writer.err == nil {
+		writer.err = binary.Write(writer.w, binary.LittleEndian, value)
+	}
+}
+
+type cacheReader struct {
+	r   io.Reader
+	err error
+}
+
+func (reader *cacheReader) read(value interface{}) {
+	if reader.err == nil {
+		reader.err = binary.Read(reader.r, binary.LittleEndian, value)
+	}
+}
+
+func (reader *cacheReader) readUint32() uint32 {
+	var value uint32
+	reader.read(&value)
+	return value
+}
+
+// on disk layout of a bvh node
+type cachedBVHNode struct {
+	Aabb  geometry.AABB
+	Right uint32
+	First uint32
+	Count uint32
+}
+
+// WriteCache serializes a built accelerator for the given meshes.
+func WriteCache(w io.Writer, scene Accelerator, meshes []mesh.Mesh) error {
+	hash, err := SceneHash(meshes)
+	if err != nil {
+		return err
+	}
+
+	writer := cacheWriter{w: w}
+	writer.write(cacheMagic)
+	writer.write(uint32(CacheVersion))
+
+	switch scene := scene.(type) {
+	case *BVH:
+		writer.write(cacheKindBVH)
+		writer.write(hash)
+
+		writer.write(uint32(len(scene.Tree.Nodes)))
+		for _, node := range scene.Tree.Nodes {
+			writer.write(cachedBVHNode{
+				Aabb:  node.Aabb,
+				Right: uint32(node.Right),
+				First: uint32(node.First),
+				Count: uint32(node.Count),
+			})
+		}
+
+		writer.write(uint32(len(scene.Tree.Indices)))
+		for _, index := range scene.Tree.Indices {
+			writer.write(uint32(index))
+		}
+	case *OctTree:
+		writer.write(cacheKindOctTree)
+		writer.write(hash)
+		writeOctTreeNode(&writer, &scene.RootNode)
+	default:
+		return fmt.Errorf("accel: cannot cache accelerator of type %T", scene)
+	}
+
+	return writer.err
+}
+
+func writeOctTreeNode(writer *cacheWriter, node *OctTreeNode) {
+	writer.write(node.Aabb)
+	writer.write(uint32(node.Depth))
+	writer.write(uint8(len(node.Children)))
+	writer.write(uint32(len(node.IntersectCandidates)))
+	for _, candidate := range node.IntersectCandidates {
+		writer.write(uint32(candidate.Index))
+	}
+
+	for i := range node.Children {
+		writeOctTreeNode(writer, &node.Children[i])
+	}
+}
+
+// ReadCache loads an accelerator written by WriteCache and resolves it against meshes.
+// It returns ErrSceneMismatch if meshes differ from the ones the cache was built for.
+func ReadCache(r io.Reader, meshes []mesh.Mesh) (Accelerator, error) {
+	reader := cacheReader{r: r}
+
+	var magic [4]byte
+	var version uint32
+	var kind uint8
+	var hash [32]byte
+	reader.read(&magic)
+	reader.read(&version)
+
+	if reader.err != nil {
+		return nil, fmt.Errorf("accel: reading cache header: %w", reader.err)
+	}
+	if magic != cacheMagic {
+		return nil, errors.New("accel: not an accelerator cache")
+	}
+	if version != CacheVersion {
+		return nil, fmt.Errorf("%w %d, expected %d", ErrCacheVersion, version, CacheVersion)
+	}
+
+	reader.read(&kind)
+	reader.read(&hash)
+	if reader.err != nil {
+		return nil, fmt.Errorf("accel: reading cache header: %w", reader.err)
+	}
+
+	expected, err := SceneHash(meshes)
+	if err != nil {
+		return nil, err
+	}
+	if hash != expected {
+		return nil, ErrSceneMismatch
+	}
+
+	candidates := collectCandidates(meshes)
+	bounded, unbounded := splitUnbounded(candidates)
+
+	var scene Accelerator
+	switch kind {
+	case cacheKindBVH:
+		bvh, err := readBVH(&reader, bounded, unbounded)
+		if err != nil {
+			return nil, err
+		}
+		scene = bvh
+	case cacheKindOctTree:
+		tree, err := readOctTree(&reader, candidates, unbounded)
+		if err != nil {
+			return nil, err
+		}
+		scene = tree
+	default:
+		return nil, fmt.Errorf("accel: unknown accelerator kind %d in cache", kind)
+	}
+
+	// the accelerator has to end the cache, anything after it means the file isn't what it seems
+	var extra [1]byte
+	switch _, err := io.ReadFull(r, extra[:]); {
+	case err == nil:
+		return nil, errors.New("accel: corrupt cache, trailing data after the accelerator")
+	case err != io.EOF:
+		return nil, fmt.Errorf("accel: reading cache: %w", err)
+	}
+
+	return scene, nil
+}
+
+func readBVH(reader *cacheReader, bounded []IntersectCandidate, unbounded []IntersectCandidate) (*BVH, error) {
+	nodeCount := int(reader.readUint32())
+	if reader.err == nil && (nodeCount > 2*len(bounded) || nodeCount == 0 && len(bounded) > 0) {
+		return nil, fmt.Errorf("accel: corrupt cache, %d bvh nodes for %d primitives", nodeCount, len(bounded))
+	}
+
+	tree := geometry.BVH{Nodes: make([]geometry.BVHNode, nodeCount)}
+	for i := range tree.Nodes {
+		var node cachedBVHNode
+		reader.read(&node)
+		tree.Nodes[i] = geometry.BVHNode{
+			Aabb:  node.Aabb,
+			Right: int(node.Right),
+			First: int(node.First),
+			Count: int(node.Count),
+		}
+	}
+
+	indexCount := int(reader.readUint32())
+	if reader.err == nil && indexCount != len(bounded) {
+		return nil, fmt.Errorf("accel: corrupt cache, %d bvh indices for %d primitives", indexCount, len(bounded))
+	}
+
+	tree.Indices = make([]int, indexCount)
+	for i := range tree.Indices {
+		tree.Indices[i] = int(reader.readUint32())
+	}
+
+	if reader.err != nil {
+		return nil, fmt.Errorf("accel: reading bvh: %w", reader.err)
+	}
+
+	// the leaves have to cover every index exactly once, and the indices every primitive
+	covered := make([]bool, len(tree.Indices))
+	for i, node := range tree.Nodes {
+		if node.IsLeaf() && node.First+node.Count > len(tree.Indices) ||
+			!node.IsLeaf() && (node.Right <= i+1 || node.Right >= len(tree.Nodes)) {
+			return nil, fmt.Errorf("accel: corrupt cache, bvh node %d out of range", i)
+		}
+		for j := node.First; j < node.First+node.Count; j++ {
+			if covered[j] {
+				return nil, fmt.Errorf("accel: corrupt cache, bvh leaves overlap at index %d", j)
+			}
+			covered[j] = true
+		}
+	}
+	for j, ok := range covered {
+		if !ok {
+			return nil, fmt.Errorf("accel: corrupt cache, no bvh leaf covers index %d", j)
+		}
+	}
+
+	seen := make([]bool, len(bounded))
+	for _, index := range tree.Indices {
+		if index < 0 || index >= len(bounded) {
+			return nil, fmt.Errorf("accel: corrupt cache, primitive index %d out of range", index)
+		}
+		if seen[index] {
+			return nil, fmt.Errorf("accel: corrupt cache, primitive %d is referenced twice", index)
+		}
+		seen[index] = true
+	}
+
+	bvh := newBVH(tree, bounded, unbounded)
+	return &bvh, nil
+}
+
+func readOctTree(reader *cacheReader, candidates []IntersectCandidate, unbounded []IntersectCandidate) (*OctTree, error) {
+	root, err := readOctTreeNode(reader, candidates, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OctTree{RootNode: root, Unbounded: unbounded}, nil
+}
+
+func readOctTreeNode(reader *cacheReader, candidates []IntersectCandidate, expectedDepth int) (OctTreeNode, error) {
+	node := OctTreeNode{}
+	var depth uint32
+	var childCount uint8
+
+	reader.read(&node.Aabb)
+	reader.read(&depth)
+	reader.read(&childCount)
+	candidateCount := int(reader.readUint32())
+
+	if reader.err != nil {
+		return node, fmt.Errorf("accel: reading octree: %w", reader.err)
+	}
+	if int(depth) != expectedDepth || depth > MaxDepth || (childCount != 0 && childCount != 8) || candidateCount > len(candidates) {
+		return node, errors.New("accel: corrupt cache, invalid octree node")
+	}
+
+	node.Depth = int(depth)
+
+	if candidateCount > 0 {
+		node.IntersectCandidates = make([]IntersectCandidate, candidateCount)
+		for i := range node.IntersectCandidates {
+			index := int(reader.readUint32())
+			if reader.err == nil && index >= len(candidates) {
+				return node, fmt.Errorf("accel: corrupt cache, primitive index %d out of range", index)
+			}
+			if reader.err == nil {
+				node.IntersectCandidates[i] = candidates[index]
+			}
+		}
+	}
+
+	if childCount > 0 {
+		node.Children = make([]OctTreeNode, childCount)
+		for i := range node.Children {
+			child, err := readOctTreeNode(reader, candidates, expectedDepth+1)
+			if err != nil {
+				return node, err
+			}
+			node.Children[i] = child
+		}
+	}
+
+	if reader.err != nil {
+		return node, fmt.Errorf("accel: reading octree: %w", reader.err)
+	}
+
+	return node, nil
+}
+
+// SaveCache writes a built accelerator to filename.
+func SaveCache(filename string, scene Accelerator, meshes []mesh.Mesh) error {
+	var buffer bytes.Buffer
+	if err := WriteCache(&buffer, scene, meshes); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buffer.Bytes(), 0644)
+}
+
+// LoadCache reads an accelerator cached in filename for meshes.
+func LoadCache(filename string, meshes []mesh.Mesh) (Accelerator, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return ReadCache(bufio.NewReader(f), meshes)
+}
@@ -0,0 +1,185 @@
+package accel_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"goraytracer/accel"
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/mesh"
+	"goraytracer/vec3"
+	"math"
+	"testing"
+)
+
+func TestCacheRoundTrip(t *testing.T) {
+	meshes := append(bunnyScene(t), mesh.Mesh{
+		Geometry: geometry.Plane{Id: 2, Point: vec3.Vec3{Y: -.1}, Normal: vec3.Vec3{Y: 1}},
+		Material: &material.Lambertian{},
+	})
+
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+
+	for name, built := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		var buffer bytes.Buffer
+		if err := accel.WriteCache(&buffer, built, meshes); err != nil {
+			t.Fatalf("%s: WriteCache() error = %v", name, err)
+		}
+
+		loaded, err := accel.ReadCache(&buffer, meshes)
+		if err != nil {
+			t.Fatalf("%s: ReadCache() error = %v", name, err)
+		}
+
+		for i, r := range bunnyRays(200) {
+			expected, expectedMaterial := built.ClosestHit(r, .001, math.Inf(1))
+			got, gotMaterial := loaded.ClosestHit(r, .001, math.Inf(1))
+			assertEqual(t, got, expected, fmt.Sprintf("%s hit for ray %d", name, i))
+			assertEqual(t, gotMaterial, expectedMaterial, fmt.Sprintf("%s material for ray %d", name, i))
+		}
+	}
+}
+
+func TestCacheRejectsDifferentScene(t *testing.T) {
+	meshes := bunnyScene(t)
+	bvh := accel.BuildBVH(meshes)
+
+	var buffer bytes.Buffer
+	if err := accel.WriteCache(&buffer, &bvh, meshes); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := bunnyScene(t)
+	changed[1].Geometry = geometry.Sphere{Id: 1, Center: vec3.Vec3{X: .6, Y: .2, Z: 0}, Radius: .3}
+
+	if _, err := accel.ReadCache(&buffer, changed); !errors.Is(err, accel.ErrSceneMismatch) {
+		t.Errorf("ReadCache() error = %v, want %v", err, accel.ErrSceneMismatch)
+	}
+}
+
+func TestCacheRejectsOtherVersions(t *testing.T) {
+	meshes := bunnyScene(t)
+	bvh := accel.BuildBVH(meshes)
+
+	var buffer bytes.Buffer
+	if err := accel.WriteCache(&buffer, &bvh, meshes); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buffer.Bytes()
+	binary.LittleEndian.PutUint32(data[4:8], accel.CacheVersion+1)
+
+	if _, err := accel.ReadCache(bytes.NewReader(data), meshes); !errors.Is(err, accel.ErrCacheVersion) {
+		t.Errorf("ReadCache() error = %v, want %v", err, accel.ErrCacheVersion)
+	}
+}
+
+func TestCacheTruncated(t *testing.T) {
+	meshes := bunnyScene(t)
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+
+	for name, built := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		var buffer bytes.Buffer
+		if err := accel.WriteCache(&buffer, built, meshes); err != nil {
+			t.Fatal(err)
+		}
+
+		data := buffer.Bytes()
+		for _, length := range []int{0, 3, 20, len(data) / 2, len(data) - 1} {
+			if _, err := accel.ReadCache(bytes.NewReader(data[:length]), meshes); err == nil {
+				t.Errorf("%s: ReadCache() of %d bytes succeeded", name, length)
+			}
+		}
+	}
+}
+
+func TestCacheRejectsTrailingData(t *testing.T) {
+	meshes := bunnyScene(t)
+	tree := accel.BuildOctTree(meshes)
+	bvh := accel.BuildBVH(meshes)
+
+	for name, built := range map[string]accel.Accelerator{"octree": &tree, "bvh": &bvh} {
+		var buffer bytes.Buffer
+		if err := accel.WriteCache(&buffer, built, meshes); err != nil {
+			t.Fatal(err)
+		}
+
+		data := append(buffer.Bytes(), 0)
+		if _, err := accel.ReadCache(bytes.NewReader(data), meshes); err == nil {
+			t.Errorf("%s: ReadCache() with a trailing byte succeeded", name)
+		}
+	}
+}
+
+// byte offsets of a bvh cache, see the layout in cache.go
+const (
+	bvhNodesOffset = 4 + 4 + 1 + 32 + 4
+	bvhNodeSize    = 6*8 + 3*4
+)
+
+func TestCacheRejectsCorruptBVH(t *testing.T) {
+	meshes := bunnyScene(t)
+	bvh := accel.BuildBVH(meshes)
+
+	var buffer bytes.Buffer
+	if err := accel.WriteCache(&buffer, &bvh, meshes); err != nil {
+		t.Fatal(err)
+	}
+	valid := buffer.Bytes()
+	indicesOffset := bvhNodesOffset + bvhNodeSize*len(bvh.Tree.Nodes)
+
+	leaf := -1
+	for i, node := range bvh.Tree.Nodes {
+		if node.Count > 1 {
+			leaf = i
+			break
+		}
+	}
+	if leaf < 0 {
+		t.Fatal("no leaf with more than one primitive")
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(data []byte) []byte
+	}{
+		{
+			name: "no nodes",
+			corrupt: func(data []byte) []byte {
+				binary.LittleEndian.PutUint32(data[bvhNodesOffset-4:], 0)
+				return append(data[:bvhNodesOffset], data[indicesOffset:]...)
+			},
+		},
+		{
+			name: "leaf skipping a primitive",
+			corrupt: func(data []byte) []byte {
+				count := data[bvhNodesOffset+bvhNodeSize*leaf+6*8+2*4:]
+				binary.LittleEndian.PutUint32(count, uint32(bvh.Tree.Nodes[leaf].Count-1))
+				return data
+			},
+		},
+		{
+			name: "primitive referenced twice",
+			corrupt: func(data []byte) []byte {
+				copy(data[indicesOffset+8:indicesOffset+12], data[indicesOffset+4:indicesOffset+8])
+				return data
+			},
+		},
+	}
+
+	if _, err := accel.ReadCache(bytes.NewReader(valid), meshes); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.corrupt(append([]byte(nil), valid...))
+			if _, err := accel.ReadCache(bytes.NewReader(data), meshes); err == nil {
+				t.Error("ReadCache() of a corrupt bvh succeeded")
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package accel
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/mesh"
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"sync"
+	"unsafe"
+)
+
+const MaxDepth = 5
+
+// nodes above this depth build their children on separate goroutines
+const octTreeParallelDepth = 2
+
+type OctTreeNode struct {
+	Aabb                geometry.AABB
+	Children            []OctTreeNode
+	IntersectCandidates []IntersectCandidate
+	Depth               int
+}
+
+type OctTree struct {
+	RootNode OctTreeNode
+
+	// geometry without finite bounds, tested against every ray
+	Unbounded []IntersectCandidate
+}
+
+// visits children front to back, skipping any that start beyond the closest hit found so far
+func (node *OctTreeNode) closestHit(ray *ray.Ray, minDistance float64, closest *closestHit) {
+	if closest.stats != nil {
+		closest.stats.NodesVisited++
+	}
+
+	if node.Depth == MaxDepth {
+		closest.test(node.IntersectCandidates, ray, minDistance)
+		return
+	}
+
+	var order [8]struct {
+		child *OctTreeNode
+		entry float64
+	}
+	count := 0
+
+	for i := range node.Children {
+		child := &node.Children[i]
+		entry, hit := child.Aabb.RayEntry(ray, minDistance, closest.maxDistance)
+		if !hit {
+			continue
+		}
+
+		// insertion sort by entry distance
+		j := count
+		for ; j > 0 && order[j-1].entry > entry; j-- {
+			order[j] = order[j-1]
+		}
+		order[j].child = child
+		order[j].entry = entry
+		count++
+	}
+
+	for _, visit := range order[:count] {
+		if visit.entry > closest.maxDistance {
+			break
+		}
+		visit.child.closestHit(ray, minDistance, closest)
+	}
+}
+
+func (tree *OctTree) ClosestHit(ray *ray.Ray, minDistance float64, maxDistance float64) (geometry.HitRecord, material.Material) {
+	closest := closestHit{maxDistance: maxDistance}
+	closest.test(tree.Unbounded, ray, minDistance)
+
+	if _, hit := tree.RootNode.Aabb.RayEntry(ray, minDistance, closest.maxDistance); hit {
+		tree.RootNode.closestHit(ray, minDistance, &closest)
+	}
+
+	return closest.record, closest.material
+}
+
+func (tree *OctTree) TraversalCost(ray *ray.Ray, minDistance float64, maxDistance float64) TraversalStats {
+	stats := TraversalStats{}
+	closest := closestHit{maxDistance: maxDistance, stats: &stats}
+	closest.test(tree.Unbounded, ray, minDistance)
+
+	if _, hit := tree.RootNode.Aabb.RayEntry(ray, minDistance, closest.maxDistance); hit {
+		tree.RootNode.closestHit(ray, minDistance, &closest)
+	}
+
+	return stats
+}
+
+func (node *OctTreeNode) addStats(stats *Stats) {
+	stats.Nodes++
+	stats.MemoryBytes += int(unsafe.Sizeof(*node)) + len(node.IntersectCandidates)*int(unsafe.Sizeof(IntersectCandidate{}))
+
+	if len(node.Children) == 0 {
+		stats.addLeaf(len(node.IntersectCandidates))
+	}
+
+	for i := range node.Children {
+		node.Children[i].addStats(stats)
+	}
+}
+
+// Primitives spanning several cells are counted once per leaf they're in.
+func (tree *OctTree) Stats() Stats {
+	stats := Stats{Unbounded: len(tree.Unbounded)}
+	stats.MemoryBytes = len(tree.Unbounded) * int(unsafe.Sizeof(IntersectCandidate{}))
+	tree.RootNode.addStats(&stats)
+	return stats.finish()
+}
+
+func (node *OctTreeNode) occluded(ray *ray.Ray, minDistance float64, maxDistance float64) bool {
+	if _, hit := node.Aabb.RayEntry(ray, minDistance, maxDistance); !hit {
+		return false
+	}
+
+	if node.Depth == MaxDepth {
+		return anyOccludes(node.IntersectCandidates, ray, minDistance, maxDistance)
+	}
+
+	for i := range node.Children {
+		if node.Children[i].occluded(ray, minDistance, maxDistance) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (tree *OctTree) Occluded(ray *ray.Ray, minDistance float64, maxDistance float64) bool {
+	return anyOccludes(tree.Unbounded, ray, minDistance, maxDistance) ||
+		tree.RootNode.occluded(ray, minDistance, maxDistance)
+}
+
+// returns the candidates whose geometry intersects aabb
+func candidatesInAABB(candidates []IntersectCandidate, aabb geometry.AABB) []IntersectCandidate {
+	intersected := make([]IntersectCandidate, 0)
+
+	for _, candidate := range candidates {
+		if candidate.Geometry.IntersectsAABB(aabb) {
+			intersected = append(intersected, candidate)
+		}
+	}
+
+	return intersected
+}
+
+// split an AABB into 8 sub AABBs
+func SplitAABB(parent geometry.AABB) []geometry.AABB {
+
+	half := vec3.MultiplyScalar(vec3.Sub(parent.Max, parent.Min), 0.5)
+
+	child0 := geometry.AABB{
+		Min: parent.Min,
+		Max: vec3.Sub(parent.Max, half)}
+
+	child1 := geometry.AABB{
+		Min: vec3.Add(parent.Min, vec3.Vec3{Z: half.Z}),
+		Max: vec3.Sub(parent.Max, vec3.Vec3{X: half.X, Y: half.Y})}
+
+	child2 := geometry.AABB{
+		Min: vec3.Add(parent.Min, vec3.Vec3{X: half.X, Z: half.Z}),
+		Max: vec3.Sub(parent.Max, vec3.Vec3{Y: half.Y})}
+
+	child3 := geometry.AABB{
+		Min: vec3.Add(parent.Min, vec3.Vec3{X: half.X}),
+		Max: vec3.Sub(parent.Max, vec3.Vec3{Y: half.Y, Z: half.Z})}
+
+	child4 := geometry.AABB{
+		Min: vec3.Add(parent.Min, vec3.Vec3{Y: half.Y}),
+		Max: vec3.Sub(parent.Max, vec3.Vec3{X: half.X, Z: half.Z})}
+
+	child5 := geometry.AABB{
+		Min: vec3.Add(parent.Min, vec3.Vec3{Y: half.Y, Z: half.Z}),
+		Max: vec3.Sub(parent.Max, vec3.Vec3{X: half.X})}
+
+	child6 := geometry.AABB{
+		Min: vec3.Add(parent.Min, half),
+		Max: parent.Max}
+
+	child7 := geometry.AABB{
+		Min: vec3.Add(parent.Min, vec3.Vec3{X: half.X, Y: half.Y}),
+		Max: vec3.Sub(parent.Max, vec3.Vec3{Z: half.Z})}
+
+	children := make([]geometry.AABB, 8)
+	children[0] = child0
+	children[1] = child1
+	children[2] = child2
+	children[3] = child3
+	children[4] = child4
+	children[5] = child5
+	children[6] = child6
+	children[7] = child7
+	return children
+}
+
+// buildOctTreeNode only considers the candidates of its parent, since a child cell
+// can't contain anything its parent doesn't.
+// The top levels build their children concurrently. Each child is written to its own
+// slot and filtering keeps candidate order, so the result doesn't depend on scheduling.
+func buildOctTreeNode(candidates []IntersectCandidate, aabb geometry.AABB, depth int) OctTreeNode {
+	node := OctTreeNode{
+		Aabb:                aabb,
+		Children:            nil,
+		IntersectCandidates: nil,
+		Depth:               depth,
+	}
+
+	geo := candidatesInAABB(candidates, aabb)
+
+	if len(geo) > 0 {
+		if depth < MaxDepth {
+			node.Children = make([]OctTreeNode, 8)
+			childAABBs := SplitAABB(aabb)
+
+			if depth < octTreeParallelDepth {
+				wg := sync.WaitGroup{}
+				for i, childAABB := range childAABBs {
+					wg.Add(1)
+					go func(i int, childAABB geometry.AABB) {
+						defer wg.Done()
+						node.Children[i] = buildOctTreeNode(geo, childAABB, depth+1)
+					}(i, childAABB)
+				}
+				wg.Wait()
+			} else {
+				for i, childAABB := range childAABBs {
+					node.Children[i] = buildOctTreeNode(geo, childAABB, depth+1)
+				}
+			}
+		} else {
+			node.IntersectCandidates = geo
+		}
+	}
+
+	return node
+}
+
+func BuildOctTree(meshes []mesh.Mesh) OctTree {
+	bounded, unbounded := splitUnbounded(collectCandidates(meshes))
+
+	// an empty scene gets a childless root that no ray can reach
+	if len(bounded) == 0 {
+		return OctTree{RootNode: OctTreeNode{Aabb: geometry.EmptyAABB()}, Unbounded: unbounded}
+	}
+
+	return OctTree{
+		RootNode:  buildOctTreeNode(bounded, sceneBounds(bounded), 0),
+		Unbounded: unbounded,
+	}
+}
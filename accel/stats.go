@@ -0,0 +1,57 @@
+package accel
+
+import "fmt"
+
+// Stats summarizes a built accelerator.
+type Stats struct {
+	Nodes int
+
+	// Leaves counts nodes without children, EmptyLeaves those of them without primitives.
+	Leaves      int
+	EmptyLeaves int
+
+	// primitive references stored in leaves
+	Primitives               int
+	MaxPrimitivesPerLeaf     int
+	AveragePrimitivesPerLeaf float64 // over non-empty leaves
+
+	// unbounded geometry tested against every ray
+	Unbounded int
+
+	// approximate size of the structure, not counting the geometry it references
+	MemoryBytes int
+}
+
+// TraversalStats counts the work done to trace a single ray.
+type TraversalStats struct {
+	NodesVisited     int
+	PrimitivesTested int
+}
+
+func (stats *Stats) addLeaf(primitives int) {
+	stats.Leaves++
+	stats.Primitives += primitives
+
+	if primitives == 0 {
+		stats.EmptyLeaves++
+		return
+	}
+
+	if primitives > stats.MaxPrimitivesPerLeaf {
+		stats.MaxPrimitivesPerLeaf = primitives
+	}
+}
+
+// finish derives the average once every leaf has been added
+func (stats Stats) finish() Stats {
+	if filled := stats.Leaves - stats.EmptyLeaves; filled > 0 {
+		stats.AveragePrimitivesPerLeaf = float64(stats.Primitives) / float64(filled)
+	}
+	return stats
+}
+
+func (stats Stats) String() string {
+	return fmt.Sprintf("%d nodes, %d leaves (%d empty), %d primitives, %d max / %.2f avg per leaf, %d unbounded, ~%d KiB",
+		stats.Nodes, stats.Leaves, stats.EmptyLeaves, stats.Primitives,
+		stats.MaxPrimitivesPerLeaf, stats.AveragePrimitivesPerLeaf, stats.Unbounded, stats.MemoryBytes/1024)
+}
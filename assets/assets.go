@@ -0,0 +1,141 @@
+// Package assets embeds sample meshes for tests and demos: the Stanford bunny, a teapot and the
+// Cornell box. They are stored as PLY and OBJ files and read with the regular loaders.
+package assets
+
+import (
+	"embed"
+	"fmt"
+	"goraytracer/camera"
+	"goraytracer/geometry"
+	"goraytracer/mesh"
+	"goraytracer/obj"
+	"goraytracer/ply"
+	"goraytracer/vec3"
+)
+
+//go:embed bunny.ply teapot.obj cornell_box.obj cornell_box.mtl
+var files embed.FS
+
+// the asset names Load accepts, in the order Names lists them
+var loaders = []struct {
+	name string
+	load func() ([]mesh.Mesh, error)
+}{
+	{name: "bunny", load: loadBunny},
+	{name: "teapot", load: loadTeapot},
+	{name: "cornell_box", load: CornellBox},
+}
+
+// Names returns the names of the assets Load accepts.
+func Names() []string {
+	names := make([]string, len(loaders))
+	for i, loader := range loaders {
+		names[i] = loader.name
+	}
+	return names
+}
+
+// Load returns the meshes of a named asset, with the materials it comes with or a gray diffuse one.
+func Load(name string) ([]mesh.Mesh, error) {
+	for _, loader := range loaders {
+		if loader.name == name {
+			return loader.load()
+		}
+	}
+	return nil, fmt.Errorf("assets: unknown asset %q", name)
+}
+
+// Bunny returns the Stanford bunny with smooth, angle weighted vertex normals. It stands about
+// a unit tall on the y = 0 plane.
+func Bunny() (*geometry.TriangleMesh, error) {
+	f, err := files.Open("bunny.ply")
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	scan, err := ply.Read(0, f, "bunny.ply")
+	if err != nil {
+		return nil, err
+	}
+
+	// the scan is in decimetres. The mesh's BVH is built from its positions, so the scaled
+	// ones make a new mesh
+	positions := make([]vec3.Vec3, len(scan.Positions))
+	for i, position := range scan.Positions {
+		positions[i] = vec3.MultiplyScalar(position, .1)
+	}
+	bunny, err := geometry.NewTriangleMesh(0, positions, scan.Indices)
+	if err != nil {
+		return nil, err
+	}
+	bunny.ComputeNormals(geometry.AngleWeighted)
+
+	return bunny, nil
+}
+
+// BunnyPolygon returns the bunny as flat shaded triangles, each with its face index as Id.
+func BunnyPolygon() (geometry.Polygon, error) {
+	bunny, err := Bunny()
+	if err != nil {
+		return geometry.Polygon{}, err
+	}
+
+	triangles := make([]geometry.Triangle, bunny.TriangleCount())
+	for i := range triangles {
+		p := bunny.Indices[3*i : 3*i+3]
+		triangles[i] = geometry.NewTriangle(bunny.Positions[p[0]], bunny.Positions[p[1]], bunny.Positions[p[2]])
+		triangles[i].Id = uint32(i)
+	}
+
+	return geometry.NewPolygon(0, triangles), nil
+}
+
+func loadBunny() ([]mesh.Mesh, error) {
+	bunny, err := Bunny()
+	if err != nil {
+		return nil, err
+	}
+	return []mesh.Mesh{{Geometry: bunny, Material: obj.DefaultMaterial()}}, nil
+}
+
+// Teapot returns a smooth shaded teapot standing on the y = 0 plane, its spout pointing +x.
+func Teapot() (*geometry.TriangleMesh, error) {
+	f, err := files.Open("teapot.obj")
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	file, err := obj.Parse(f, "teapot.obj")
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Objects) != 1 {
+		return nil, fmt.Errorf("assets: teapot.obj has %d objects, want 1", len(file.Objects))
+	}
+
+	return file.Objects[0].Mesh, nil
+}
+
+func loadTeapot() ([]mesh.Mesh, error) {
+	teapot, err := Teapot()
+	if err != nil {
+		return nil, err
+	}
+	return []mesh.Mesh{{Geometry: teapot, Material: obj.DefaultMaterial()}}, nil
+}
+
+// CornellBox returns the walls, light and blocks of the Cornell box, in millimetres, with their
+// diffuse and emissive materials. The opening faces -z.
+func CornellBox() ([]mesh.Mesh, error) {
+	return obj.LoadFS(files, "cornell_box.obj")
+}
+
+// CornellBoxCamera returns the camera of the Cornell box measurements: 800mm in front of the
+// opening, looking into the box, with the field of view of a 35mm lens on 25mm film.
+func CornellBoxCamera(aspectRatio float64) camera.Camera {
+	return camera.New(vec3.Vec3{X: 278, Y: 273, Z: -800}, vec3.Vec3{X: 278, Y: 273}, 39.3, aspectRatio)
+}
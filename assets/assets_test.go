@@ -0,0 +1,169 @@
+package assets_test
+
+import (
+	"goraytracer/accel"
+	"goraytracer/assets"
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/transform"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		meshes    int
+		triangles int
+	}{
+		{name: "bunny", meshes: 1, triangles: 3674},
+		{name: "teapot", meshes: 1, triangles: 2112},
+		{name: "cornell_box", meshes: 8, triangles: 32},
+	}
+	if len(tests) != len(assets.Names()) {
+		t.Errorf("Names() = %v", assets.Names())
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if assets.Names()[i] != tt.name {
+				t.Errorf("Names()[%d] = %q", i, assets.Names()[i])
+			}
+
+			meshes, err := assets.Load(tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(meshes) != tt.meshes {
+				t.Errorf("got %d meshes, want %d", len(meshes), tt.meshes)
+			}
+
+			bvh := accel.BuildBVH(meshes)
+			if got := len(bvh.Candidates); got != tt.triangles {
+				t.Errorf("got %d triangles, want %d", got, tt.triangles)
+			}
+		})
+	}
+
+	if _, err := assets.Load("dragon"); err == nil {
+		t.Error(`Load("dragon") succeeded`)
+	}
+}
+
+func TestBunny(t *testing.T) {
+	bunny, err := assets.Bunny()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bunny.Normals) != len(bunny.Positions) {
+		t.Fatalf("got %d normals for %d positions", len(bunny.Normals), len(bunny.Positions))
+	}
+
+	polygon, err := assets.BunnyPolygon()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(polygon.Triangles) != bunny.TriangleCount() || polygon.Triangles[7].Id != 7 {
+		t.Errorf("polygon has %d triangles, want %d", len(polygon.Triangles), bunny.TriangleCount())
+	}
+
+	for _, p := range bunny.Positions {
+		if p.Y < -.01 || p.Y > 1 || math.Abs(p.X) > .5 || math.Abs(p.Z) > .5 {
+			t.Fatalf("position %v is outside the unit bunny", p)
+		}
+	}
+}
+
+// the closest hit of r among all of the mesh's triangles
+func bruteForceHit(mesh *geometry.TriangleMesh, r *ray.Ray) geometry.HitRecord {
+	closest := geometry.HitRecord{}
+	maxDistance := math.Inf(1)
+	for i := 0; i < mesh.TriangleCount(); i++ {
+		if hit := mesh.Triangle(i).Hit(r, .001, maxDistance); hit.Hit {
+			closest = hit
+			maxDistance = hit.Distance
+		}
+	}
+	return closest
+}
+
+// rays from around the bunny towards random points within its bounds
+func bunnyRays(r *rand.Rand, bounds geometry.AABB, count int) []*ray.Ray {
+	center := bounds.Centroid()
+	size := vec3.Sub(bounds.Max, bounds.Min)
+
+	rays := make([]*ray.Ray, count)
+	for i := range rays {
+		origin := vec3.Add(center, vec3.MultiplyScalar(vec3.RandomInUnitSphere(r).Normalized(), 2*size.Length()))
+		target := vec3.Add(bounds.Min, vec3.Vec3{X: r.Float64() * size.X, Y: r.Float64() * size.Y, Z: r.Float64() * size.Z})
+		rays[i] = ray.New(origin, vec3.Sub(target, origin))
+	}
+	return rays
+}
+
+func TestBunnyHitMatchesBruteForce(t *testing.T) {
+	bunny, err := assets.Bunny()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objectToWorld := transform.Compose(
+		transform.Scale(vec3.Vec3{X: 2, Y: 1, Z: 2}),
+		transform.RotateY(1),
+		transform.Translate(vec3.Vec3{X: 3, Z: -2}),
+	)
+	instance, _ := geometry.NewInstance(1, bunny, objectToWorld)
+	worldToObject, _ := objectToWorld.InverseAffine()
+
+	r := rand.New(rand.NewSource(0))
+	hits := 0
+	for i, rr := range bunnyRays(r, bunny.BoundingBox(), 500) {
+		expected := bruteForceHit(bunny, rr)
+		got := bunny.Hit(rr, .001, math.Inf(1))
+		if got.Hit != expected.Hit || got.Distance != expected.Distance {
+			t.Fatalf("ray %d: Hit() = %v at %v, want %v at %v", i, got.Hit, got.Distance, expected.Hit, expected.Distance)
+		}
+		if got.Hit {
+			hits++
+		}
+	}
+	if hits < 100 {
+		t.Errorf("only %d of 500 rays hit the bunny", hits)
+	}
+
+	for i, rr := range bunnyRays(r, instance.BoundingBox(), 500) {
+		objectRay := ray.New(worldToObject.TransformPoint(rr.Origin), worldToObject.TransformVector(rr.Direction))
+		expected := bruteForceHit(bunny, objectRay)
+		got := instance.Hit(rr, .001, math.Inf(1))
+		if got.Hit != expected.Hit || got.Hit && math.Abs(got.Distance-expected.Distance) > 1e-9 {
+			t.Fatalf("ray %d: instance Hit() = %v at %v, want %v at %v", i, got.Hit, got.Distance, expected.Hit, expected.Distance)
+		}
+	}
+}
+
+func TestCornellBox(t *testing.T) {
+	meshes, err := assets.CornellBox()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var lights int
+	for _, m := range meshes {
+		if lambertian, ok := m.Material.(*material.Lambertian); ok && lambertian.Properties.EmittanceColor != (vec3.Vec3{}) {
+			lights++
+		}
+	}
+	if lights != 1 {
+		t.Errorf("got %d lights, want 1", lights)
+	}
+
+	// the camera looks through the opening at the tall block
+	bvh := accel.BuildBVH(meshes)
+	camera := assets.CornellBoxCamera(1)
+	hit, _ := bvh.ClosestHit(camera.GetRay(.5, .5), .001, math.Inf(1))
+	if !hit.Hit || hit.Point.Z < 0 || hit.Point.Z > 559.2 {
+		t.Errorf("center ray hit = %+v, want inside the box", hit)
+	}
+}
@@ -14,14 +14,18 @@ type Camera struct {
 }
 
 func New(eye vec3.Vec3, look vec3.Vec3, verticalFovDegrees float64, aspectRatio float64) Camera {
+	return NewWithUp(eye, look, vec3.Vec3{Y: 1}, verticalFovDegrees, aspectRatio)
+}
+
+// NewWithUp is New for cameras that aren't upright: the top of the image faces up,
+// which must not be parallel to the view direction.
+func NewWithUp(eye vec3.Vec3, look vec3.Vec3, up vec3.Vec3, verticalFovDegrees float64, aspectRatio float64) Camera {
 	theta := verticalFovDegrees * 0.0174533
 	h := math.Tan(theta / 2.0)
 
 	viewportHeight := 2.0 * h
 	viewportWidth := viewportHeight * aspectRatio
 
-	up := vec3.Vec3{Y: 1}
-
 	w := vec3.Sub(eye, look).Normalized()
 	u := vec3.Cross(up, w).Normalized()
 	v := vec3.Cross(w, u)
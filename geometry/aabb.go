@@ -35,3 +35,83 @@ func (aabb AABB) IntersectsRay(ray *ray.Ray) bool {
 
 	return tmax >= math.Max(0.0, tmin)
 }
+
+// EmptyAABB returns an inverted box that contains nothing, the identity for Union.
+func EmptyAABB() AABB {
+	return AABB{
+		Min: vec3.Vec3{X: math.Inf(1), Y: math.Inf(1), Z: math.Inf(1)},
+		Max: vec3.Vec3{X: math.Inf(-1), Y: math.Inf(-1), Z: math.Inf(-1)},
+	}
+}
+
+// Union returns the smallest box enclosing both a and b.
+func Union(a AABB, b AABB) AABB {
+	return AABB{Min: vec3.Min(a.Min, b.Min), Max: vec3.Max(a.Max, b.Max)}
+}
+
+func (aabb AABB) Centroid() vec3.Vec3 {
+	return vec3.MultiplyScalar(vec3.Add(aabb.Min, aabb.Max), 0.5)
+}
+
+func (aabb AABB) SurfaceArea() float64 {
+	d := vec3.Sub(aabb.Max, aabb.Min)
+	return 2.0 * (d.X*d.Y + d.Y*d.Z + d.Z*d.X)
+}
+
+// RayEntry returns the distance at which ray enters the box, clipped to [minDistance, maxDistance].
+// It reports false when the ray misses the box within that interval.
+// Unlike IntersectsRay, rays running parallel inside a face count as hits,
+// so a ray along the border between two octree cells is not lost by both.
+func (aabb AABB) RayEntry(ray *ray.Ray, minDistance float64, maxDistance float64) (float64, bool) {
+	tmin := minDistance
+	tmax := maxDistance
+
+	slabs := [3][4]float64{
+		{aabb.Min.X, aabb.Max.X, ray.Origin.X, ray.InverseDir.X},
+		{aabb.Min.Y, aabb.Max.Y, ray.Origin.Y, ray.InverseDir.Y},
+		{aabb.Min.Z, aabb.Max.Z, ray.Origin.Z, ray.InverseDir.Z},
+	}
+
+	for _, slab := range slabs {
+		min, max, origin, inverseDir := slab[0], slab[1], slab[2], slab[3]
+
+		// parallel to the slab, inside or outside for the whole ray
+		if math.IsInf(inverseDir, 0) {
+			if origin < min || origin > max {
+				return tmin, false
+			}
+			continue
+		}
+
+		t1 := (min - origin) * inverseDir
+		t2 := (max - origin) * inverseDir
+
+		tmin = math.Max(tmin, math.Min(t1, t2))
+		tmax = math.Min(tmax, math.Max(t1, t2))
+	}
+
+	return tmin, tmax >= tmin
+}
+
+// InfiniteAABB returns a box enclosing all of space, the bounds of unbounded geometry like planes.
+func InfiniteAABB() AABB {
+	return AABB{
+		Min: vec3.Vec3{X: math.Inf(-1), Y: math.Inf(-1), Z: math.Inf(-1)},
+		Max: vec3.Vec3{X: math.Inf(1), Y: math.Inf(1), Z: math.Inf(1)},
+	}
+}
+
+// IsBounded reports whether the box has a finite extent on every axis.
+func (aabb AABB) IsBounded() bool {
+	for _, value := range [6]float64{aabb.Min.X, aabb.Min.Y, aabb.Min.Z, aabb.Max.X, aabb.Max.Y, aabb.Max.Z} {
+		if math.IsInf(value, 0) || math.IsNaN(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty reports whether the box contains no points, like the result of EmptyAABB.
+func (aabb AABB) IsEmpty() bool {
+	return aabb.Min.X > aabb.Max.X || aabb.Min.Y > aabb.Max.Y || aabb.Min.Z > aabb.Max.Z
+}
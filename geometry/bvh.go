@@ -0,0 +1,335 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"math"
+	"sync"
+)
+
+// The BVH only knows about bounding boxes, so it can index anything that has
+// one: the triangles of a single polygon or every primitive in a scene.
+
+const MaxBVHLeafSize = 4
+
+const (
+	// subtrees with at least this many primitives are built on their own goroutine
+	bvhParallelThreshold = 1024
+
+	bvhBinCount         = 12
+	bvhTraversalCost    = 1.0
+	bvhIntersectionCost = 1.0
+)
+
+// A node in a flattened bounding volume hierarchy.
+// Interior nodes keep their first child directly after themselves in BVH.Nodes
+// and the second child at Right. Leaves reference Count primitives starting at First.
+type BVHNode struct {
+	Aabb  AABB
+	Right int
+	First int
+	Count int
+}
+
+func (node *BVHNode) IsLeaf() bool {
+	return node.Count > 0
+}
+
+type BVH struct {
+	Nodes []BVHNode
+
+	// primitive indices, ordered so that every leaf references a contiguous run
+	Indices []int
+}
+
+type bvhBin struct {
+	bounds AABB
+	count  int
+}
+
+type bvhBuilder struct {
+	bounds    []AABB
+	centroids []AABB
+	bvh       *BVH
+}
+
+// BuildBVH builds a hierarchy over the given primitive bounds using the surface area heuristic.
+func BuildBVH(bounds []AABB) BVH {
+	bvh := BVH{Indices: make([]int, len(bounds))}
+
+	if len(bounds) == 0 {
+		return bvh
+	}
+
+	// centroids are stored as degenerate boxes so they can be unioned like any other bounds
+	centroids := make([]AABB, len(bounds))
+	for i, aabb := range bounds {
+		bvh.Indices[i] = i
+		centroid := aabb.Centroid()
+		centroids[i] = AABB{Min: centroid, Max: centroid}
+	}
+
+	builder := bvhBuilder{bounds: bounds, centroids: centroids, bvh: &bvh}
+	bvh.Nodes = builder.build(make([]BVHNode, 0, 2*len(bounds)-1), 0, len(bounds))
+
+	return bvh
+}
+
+func axisValue(aabb AABB, axis int) (float64, float64) {
+	switch axis {
+	case 0:
+		return aabb.Min.X, aabb.Max.X
+	case 1:
+		return aabb.Min.Y, aabb.Max.Y
+	default:
+		return aabb.Min.Z, aabb.Max.Z
+	}
+}
+
+// returns the bin a centroid falls into along axis
+func binIndex(centroid AABB, axis int, centroidBounds AABB) int {
+	c, _ := axisValue(centroid, axis)
+	min, max := axisValue(centroidBounds, axis)
+	bin := int(bvhBinCount * (c - min) / (max - min))
+	if bin >= bvhBinCount {
+		bin = bvhBinCount - 1
+	}
+	return bin
+}
+
+// build appends the subtree for indices [first, first+count) to nodes.
+// Node indices are relative to the start of nodes, so a subtree built into
+// its own slice can be spliced in later by offsetting them.
+func (builder *bvhBuilder) build(nodes []BVHNode, first int, count int) []BVHNode {
+	index := len(nodes)
+	nodes = append(nodes, BVHNode{})
+
+	bounds := EmptyAABB()
+	centroidBounds := EmptyAABB()
+	for _, primitive := range builder.bvh.Indices[first : first+count] {
+		bounds = Union(bounds, builder.bounds[primitive])
+		centroidBounds = Union(centroidBounds, builder.centroids[primitive])
+	}
+
+	leaf := BVHNode{Aabb: bounds, First: first, Count: count}
+
+	if count == 1 {
+		nodes[index] = leaf
+		return nodes
+	}
+
+	axis, splitBin, splitCost := builder.findSplit(first, count, bounds, centroidBounds)
+
+	// stop splitting when it's cheaper to test every primitive,
+	// or when all centroids coincide and there is nothing to split on
+	leafCost := float64(count) * bvhIntersectionCost
+	if splitBin < 0 || (count <= MaxBVHLeafSize && splitCost >= leafCost) {
+		nodes[index] = leaf
+		return nodes
+	}
+
+	// partition indices so everything left of the split comes first
+	indices := builder.bvh.Indices[first : first+count]
+	mid := 0
+	for i, primitive := range indices {
+		if binIndex(builder.centroids[primitive], axis, centroidBounds) <= splitBin {
+			indices[i], indices[mid] = indices[mid], indices[i]
+			mid++
+		}
+	}
+
+	if count < bvhParallelThreshold {
+		nodes = builder.build(nodes, first, mid)
+		right := len(nodes)
+		nodes = builder.build(nodes, first+mid, count-mid)
+		nodes[index] = BVHNode{Aabb: bounds, Right: right}
+		return nodes
+	}
+
+	// build both halves concurrently, each into its own slice. They work on disjoint
+	// ranges of Indices, and splicing them in keeps the layout identical to a serial build.
+	var left []BVHNode
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		left = builder.build(make([]BVHNode, 0, 2*mid-1), first, mid)
+	}()
+	right := builder.build(make([]BVHNode, 0, 2*(count-mid)-1), first+mid, count-mid)
+	wg.Wait()
+
+	nodes = spliceBVHNodes(nodes, left)
+	rightIndex := len(nodes)
+	nodes = spliceBVHNodes(nodes, right)
+	nodes[index] = BVHNode{Aabb: bounds, Right: rightIndex}
+	return nodes
+}
+
+// appends a subtree built into its own slice, offsetting its child indices
+func spliceBVHNodes(nodes []BVHNode, subtree []BVHNode) []BVHNode {
+	offset := len(nodes)
+	for _, node := range subtree {
+		if !node.IsLeaf() {
+			node.Right += offset
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// findSplit evaluates the binned surface area heuristic along all three axes.
+// Primitives whose centroid lands in a bin <= splitBin go left.
+// splitBin is -1 when no split separates the primitives.
+func (builder *bvhBuilder) findSplit(first int, count int, bounds AABB, centroidBounds AABB) (axis int, splitBin int, cost float64) {
+	splitBin = -1
+	cost = math.Inf(1)
+	parentArea := bounds.SurfaceArea()
+	if parentArea <= 0 {
+		// flat or collinear primitives, every split has the same relative cost
+		parentArea = 1
+	}
+
+	for a := 0; a < 3; a++ {
+		min, max := axisValue(centroidBounds, a)
+		if max <= min {
+			continue
+		}
+
+		var bins [bvhBinCount]bvhBin
+		for i := range bins {
+			bins[i].bounds = EmptyAABB()
+		}
+
+		for _, primitive := range builder.bvh.Indices[first : first+count] {
+			bin := binIndex(builder.centroids[primitive], a, centroidBounds)
+			bins[bin].count++
+			bins[bin].bounds = Union(bins[bin].bounds, builder.bounds[primitive])
+		}
+
+		// sweep from the right to accumulate the area and count of every right hand side
+		var rightArea [bvhBinCount]float64
+		var rightCount [bvhBinCount]int
+		accumulated := EmptyAABB()
+		accumulatedCount := 0
+		for i := bvhBinCount - 1; i > 0; i-- {
+			accumulated = Union(accumulated, bins[i].bounds)
+			accumulatedCount += bins[i].count
+			rightArea[i] = accumulated.SurfaceArea()
+			rightCount[i] = accumulatedCount
+		}
+
+		accumulated = EmptyAABB()
+		accumulatedCount = 0
+		for i := 0; i < bvhBinCount-1; i++ {
+			accumulated = Union(accumulated, bins[i].bounds)
+			accumulatedCount += bins[i].count
+
+			if accumulatedCount == 0 || rightCount[i+1] == 0 {
+				continue
+			}
+
+			c := bvhTraversalCost + bvhIntersectionCost*
+				(accumulated.SurfaceArea()*float64(accumulatedCount)+rightArea[i+1]*float64(rightCount[i+1]))/parentArea
+
+			if c < cost {
+				axis = a
+				splitBin = i
+				cost = c
+			}
+		}
+	}
+
+	return axis, splitBin, cost
+}
+
+type bvhStackEntry struct {
+	index int
+	entry float64
+}
+
+// Traverse visits the leaves the ray passes through, nearest first.
+// visit tests the primitives in the leaf and returns the distance of the closest hit so far,
+// which lets the traversal skip every node that starts beyond it.
+// When nodesVisited is not nil it is incremented for every node the traversal processes.
+func (bvh *BVH) Traverse(r *ray.Ray, minDistance float64, maxDistance float64, nodesVisited *int, visit func(leaf *BVHNode) float64) {
+	if len(bvh.Nodes) == 0 {
+		return
+	}
+
+	entry, hit := bvh.Nodes[0].Aabb.RayEntry(r, minDistance, maxDistance)
+	if !hit {
+		return
+	}
+
+	stack := make([]bvhStackEntry, 0, 64)
+	stack = append(stack, bvhStackEntry{index: 0, entry: entry})
+
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		// a closer hit may have been found since this node was pushed
+		if top.entry > maxDistance {
+			continue
+		}
+
+		node := &bvh.Nodes[top.index]
+
+		if nodesVisited != nil {
+			*nodesVisited++
+		}
+
+		if node.IsLeaf() {
+			maxDistance = visit(node)
+			continue
+		}
+
+		near := bvhStackEntry{index: top.index + 1}
+		far := bvhStackEntry{index: node.Right}
+		var hitNear, hitFar bool
+		near.entry, hitNear = bvh.Nodes[near.index].Aabb.RayEntry(r, minDistance, maxDistance)
+		far.entry, hitFar = bvh.Nodes[far.index].Aabb.RayEntry(r, minDistance, maxDistance)
+
+		if hitNear && hitFar && far.entry < near.entry {
+			near, far = far, near
+		}
+
+		// push the far child first so the near one is visited first
+		if hitFar {
+			stack = append(stack, far)
+		}
+		if hitNear {
+			stack = append(stack, near)
+		}
+	}
+}
+
+// TraverseAny visits the leaves the ray passes through in no particular order,
+// stopping as soon as visit reports true.
+func (bvh *BVH) TraverseAny(r *ray.Ray, minDistance float64, maxDistance float64, visit func(leaf *BVHNode) bool) bool {
+	if len(bvh.Nodes) == 0 {
+		return false
+	}
+
+	stack := make([]int, 0, 64)
+	stack = append(stack, 0)
+
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		node := &bvh.Nodes[index]
+
+		if _, hit := node.Aabb.RayEntry(r, minDistance, maxDistance); !hit {
+			continue
+		}
+
+		if node.IsLeaf() {
+			if visit(node) {
+				return true
+			}
+		} else {
+			stack = append(stack, node.Right, index+1)
+		}
+	}
+
+	return false
+}
@@ -3,26 +3,77 @@ package geometry
 import (
 	"goraytracer/ray"
 	"goraytracer/vec3"
+	"math"
 )
 
 type HitRecord struct {
 	Hit      bool
 	Distance float64 // distance from ray origin
 	Point    vec3.Vec3
-	Normal   vec3.Vec3
+	Normal   vec3.Vec3 // shading normal, always on the side of the surface the ray came from
 	U        float64
 	V        float64
+
+	// GeometricNormal is the normal of the actual surface, facing against the ray like Normal.
+	// It only differs from Normal where shading normals are interpolated.
+	GeometricNormal vec3.Vec3
+
+	// Tangent and Bitangent complete Normal to an orthonormal frame, with Tangent pointing
+	// where U grows and Bitangent where V grows. Tangent space normal maps are relative to it.
+	// Both are zero when the surface has no texture parameterization.
+	Tangent   vec3.Vec3
+	Bitangent vec3.Vec3
+
+	// FrontFace reports whether the ray hit the outside of the surface,
+	// so materials can tell entering from leaving.
+	FrontFace bool
+}
+
+// relative distance secondary rays start off the surface at
+const rayOffset = 1e-5
+
+// SpawnRay starts a secondary ray at the hit point. Its origin is pushed off the surface along
+// the geometric normal, to the side the ray leaves on, so the ray doesn't hit the surface it
+// starts on again. Interpolated shading normals don't match the surface and can't be used for this.
+func (hitRecord HitRecord) SpawnRay(direction vec3.Vec3) *ray.Ray {
+	p := hitRecord.Point
+	offset := rayOffset * (1 + math.Max(math.Abs(p.X), math.Max(math.Abs(p.Y), math.Abs(p.Z))))
+
+	normal := hitRecord.GeometricNormal
+	if vec3.Dot(direction, normal) < 0 {
+		offset = -offset
+	}
+
+	return ray.New(vec3.Add(p, vec3.MultiplyScalar(normal, offset)), direction)
+}
+
+// faceNormal orients outwardNormal against the direction the ray travels
+// and reports whether the ray arrived from the outside.
+func faceNormal(r *ray.Ray, outwardNormal vec3.Vec3) (vec3.Vec3, bool) {
+	if vec3.Dot(r.Direction, outwardNormal) < 0 {
+		return outwardNormal, true
+	}
+	return vec3.MultiplyScalar(outwardNormal, -1.0), false
 }
 
 type Geometry interface {
 	// TODO: rename this to IntersectsRay
 	Hit(r *ray.Ray, minDistance float64, maxDistance float64) HitRecord
 
+	// Reports whether the ray intersects the geometry between min and max distance.
+	// Cheaper than Hit since no HitRecord has to be filled in.
+	Occludes(r *ray.Ray, minDistance float64, maxDistance float64) bool
+
 	// Returns a reference to self or sub geometries that intersect with aabb
 	AABBIntersections(aabb AABB) []Geometry
 
 	IntersectsAABB(aabb AABB) bool
 
+	// Returns the smallest axis aligned box enclosing the geometry
+	BoundingBox() AABB
+
+	// Identifies the scene object. Parts of one object, like the triangles of a mesh,
+	// share its id, so it can't tell them apart.
 	GetId() uint32
 }
 
@@ -0,0 +1,124 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"goraytracer/transform"
+	"goraytracer/vec3"
+)
+
+// An Instance places shared geometry in the world with its own transform.
+// Rays are moved into object space for intersection, so the geometry is never copied:
+// ten instances of a Polygon share its triangles and its BVH.
+//
+// In a scene accelerator every Instance is a single primitive. Together with the
+// BVH of the geometry it references this forms a two level hierarchy, the top level over
+// instances and the bottom level per shared mesh.
+type Instance struct {
+	Id       uint32
+	Geometry Geometry
+
+	objectToWorld transform.Matrix
+	worldToObject transform.Matrix
+	bounds        AABB
+}
+
+// NewInstance places geometry in the world with an affine objectToWorld transform.
+// It reports false when the transform can't be inverted.
+func NewInstance(id uint32, geometry Geometry, objectToWorld transform.Matrix) (Instance, bool) {
+	worldToObject, ok := objectToWorld.InverseAffine()
+	if !ok {
+		return Instance{}, false
+	}
+
+	return Instance{
+		Id:            id,
+		Geometry:      geometry,
+		objectToWorld: objectToWorld,
+		worldToObject: worldToObject,
+		bounds:        transformAABB(geometry.BoundingBox(), objectToWorld),
+	}, true
+}
+
+func (instance Instance) Transform() transform.Matrix {
+	return instance.objectToWorld
+}
+
+// returns the bounds of the 8 transformed corners of aabb
+func transformAABB(aabb AABB, m transform.Matrix) AABB {
+	if !aabb.IsBounded() {
+		if aabb.IsEmpty() {
+			return aabb
+		}
+		return InfiniteAABB()
+	}
+
+	bounds := EmptyAABB()
+	for _, x := range [2]float64{aabb.Min.X, aabb.Max.X} {
+		for _, y := range [2]float64{aabb.Min.Y, aabb.Max.Y} {
+			for _, z := range [2]float64{aabb.Min.Z, aabb.Max.Z} {
+				corner := m.TransformPoint(vec3.Vec3{X: x, Y: y, Z: z})
+				bounds = Union(bounds, AABB{Min: corner, Max: corner})
+			}
+		}
+	}
+	return bounds
+}
+
+// the direction is left unnormalized, so distances along both rays are the same
+func (instance Instance) objectRay(r *ray.Ray) *ray.Ray {
+	return ray.New(instance.worldToObject.TransformPoint(r.Origin), instance.worldToObject.TransformVector(r.Direction))
+}
+
+func (instance Instance) Hit(r *ray.Ray, minDistance float64, maxDistance float64) HitRecord {
+	hitRecord := instance.Geometry.Hit(instance.objectRay(r), minDistance, maxDistance)
+	if !hitRecord.Hit {
+		return hitRecord
+	}
+
+	// n·d is the same in both spaces, so FrontFace and the normal's orientation carry over
+	hitRecord.Point = r.At(hitRecord.Distance)
+	hitRecord.Normal = instance.worldToObject.TransformNormal(hitRecord.Normal).Normalized()
+	hitRecord.GeometricNormal = instance.worldToObject.TransformNormal(hitRecord.GeometricNormal).Normalized()
+
+	// tangents lie in the surface, so they transform like any other direction.
+	// A non uniform scale shears the frame, so the bitangent is made orthogonal again.
+	if tangent := instance.objectToWorld.TransformVector(hitRecord.Tangent); !tangent.NearZero() {
+		bitangent := instance.objectToWorld.TransformVector(hitRecord.Bitangent)
+		hitRecord.Tangent = tangent.Normalized()
+		hitRecord.Bitangent = vec3.Cross(hitRecord.Normal, hitRecord.Tangent)
+		if vec3.Dot(hitRecord.Bitangent, bitangent) < 0 {
+			hitRecord.Bitangent = vec3.MultiplyScalar(hitRecord.Bitangent, -1)
+		}
+	}
+	return hitRecord
+}
+
+func (instance Instance) Occludes(r *ray.Ray, minDistance float64, maxDistance float64) bool {
+	return instance.Geometry.Occludes(instance.objectRay(r), minDistance, maxDistance)
+}
+
+// An instance is not split up in accelerators, its geometry lives in object space.
+func (instance Instance) AABBIntersections(aabb AABB) []Geometry {
+	if instance.IntersectsAABB(aabb) {
+		intersections := make([]Geometry, 1)
+		intersections[0] = instance
+		return intersections
+	}
+
+	return nil
+}
+
+// conservative: compares against the instance's world space bounds
+func (instance Instance) IntersectsAABB(aabb AABB) bool {
+	return instance.bounds.Min.X <= aabb.Max.X && instance.bounds.Max.X >= aabb.Min.X &&
+		instance.bounds.Min.Y <= aabb.Max.Y && instance.bounds.Max.Y >= aabb.Min.Y &&
+		instance.bounds.Min.Z <= aabb.Max.Z && instance.bounds.Max.Z >= aabb.Min.Z
+}
+
+func (instance Instance) BoundingBox() AABB {
+	return instance.bounds
+}
+
+func (instance Instance) GetId() uint32 {
+	return instance.Id
+}
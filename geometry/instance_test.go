@@ -0,0 +1,140 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"goraytracer/transform"
+	"goraytracer/vec3"
+	"math"
+	"testing"
+)
+
+func nearlyEqual(a vec3.Vec3, b vec3.Vec3) bool {
+	return vec3.Sub(a, b).Length() < 1e-9
+}
+
+func TestInstance_HitMatchesTransformedGeometry(t *testing.T) {
+	unitSphere := Sphere{Id: 0, Center: vec3.Vec3{}, Radius: 1}
+
+	tests := []struct {
+		name      string
+		transform transform.Matrix
+		expected  Sphere
+	}{
+		{
+			name:      "translated",
+			transform: transform.Translate(vec3.Vec3{X: 3, Y: -1, Z: 2}),
+			expected:  Sphere{Center: vec3.Vec3{X: 3, Y: -1, Z: 2}, Radius: 1},
+		},
+		{
+			name:      "scaled then translated",
+			transform: transform.Compose(transform.Scale(vec3.Vec3{X: 2, Y: 2, Z: 2}), transform.Translate(vec3.Vec3{Z: -4})),
+			expected:  Sphere{Center: vec3.Vec3{Z: -4}, Radius: 2},
+		},
+		{
+			name:      "rotated about its center",
+			transform: transform.Compose(transform.RotateY(1.2), transform.Translate(vec3.Vec3{X: 1})),
+			expected:  Sphere{Center: vec3.Vec3{X: 1}, Radius: 1},
+		},
+	}
+
+	rays := []*ray.Ray{
+		ray.New(vec3.Vec3{Z: 20}, vec3.Vec3{Z: -1}),
+		ray.New(vec3.Vec3{X: 20, Y: .5, Z: -4}, vec3.Vec3{X: -1}),
+		ray.New(vec3.Vec3{X: -10, Y: -10, Z: -10}, vec3.Vec3{X: 1, Y: 1, Z: 1}.Normalized()),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance, ok := NewInstance(1, unitSphere, tt.transform)
+			if !ok {
+				t.Fatal("NewInstance() could not invert transform")
+			}
+
+			for i, r := range rays {
+				got := instance.Hit(r, .001, math.Inf(1))
+				want := tt.expected.Hit(r, .001, math.Inf(1))
+
+				if got.Hit != want.Hit || math.Abs(got.Distance-want.Distance) > 1e-9 ||
+					!nearlyEqual(got.Point, want.Point) || !nearlyEqual(got.Normal, want.Normal) {
+					t.Errorf("ray %d: Hit() = %+v, want %+v", i, got, want)
+				}
+				if instance.Occludes(r, .001, math.Inf(1)) != want.Hit {
+					t.Errorf("ray %d: Occludes() = %v, want %v", i, !want.Hit, want.Hit)
+				}
+			}
+
+			bounds := instance.BoundingBox()
+			expectedBounds := tt.expected.BoundingBox()
+			const eps = 1e-9
+			if bounds.Min.X > expectedBounds.Min.X+eps || bounds.Min.Y > expectedBounds.Min.Y+eps || bounds.Min.Z > expectedBounds.Min.Z+eps ||
+				bounds.Max.X < expectedBounds.Max.X-eps || bounds.Max.Y < expectedBounds.Max.Y-eps || bounds.Max.Z < expectedBounds.Max.Z-eps {
+				t.Errorf("BoundingBox() = %v does not enclose %v", bounds, expectedBounds)
+			}
+		})
+	}
+}
+
+func TestInstance_NonUniformScaleNormal(t *testing.T) {
+	// a plane tilted 45 degrees, squashed along y, tilts further towards the x axis
+	triangle := NewTriangle(vec3.Vec3{X: -1, Y: -1, Z: 1}, vec3.Vec3{X: 1, Y: 1, Z: 1}, vec3.Vec3{X: 1, Y: 1, Z: -1})
+	instance, _ := NewInstance(0, triangle, transform.Scale(vec3.Vec3{X: 1, Y: .5, Z: 1}))
+
+	hit := instance.Hit(ray.New(vec3.Vec3{X: -5}, vec3.Vec3{X: 1}), 0, math.Inf(1))
+	if !hit.Hit {
+		t.Fatal("expected a hit")
+	}
+
+	expected := vec3.Vec3{X: 1, Y: -2}.Normalized()
+	if !nearlyEqual(hit.Normal, expected) && !nearlyEqual(hit.Normal, vec3.MultiplyScalar(expected, -1)) {
+		t.Errorf("Normal = %v, want ±%v", hit.Normal, expected)
+	}
+	if !nearlyEqual(hit.GeometricNormal, hit.Normal) {
+		t.Errorf("GeometricNormal = %v, want %v", hit.GeometricNormal, hit.Normal)
+	}
+}
+
+func TestInstance_TangentStaysInSurface(t *testing.T) {
+	triangle := NewTriangle(vec3.Vec3{X: -1, Y: -1, Z: 1}, vec3.Vec3{X: 1, Y: 1, Z: 1}, vec3.Vec3{X: 1, Y: 1, Z: -1})
+	triangle.UVs = &[3]TexCoord{{U: 0, V: 0}, {U: 1, V: 0}, {U: 1, V: 1}}
+	instance, _ := NewInstance(0, triangle, transform.Compose(
+		transform.Scale(vec3.Vec3{X: 1, Y: .5, Z: 3}),
+		transform.RotateZ(.3),
+	))
+
+	hit := instance.Hit(ray.New(vec3.Vec3{X: -5}, vec3.Vec3{X: 1}), 0, math.Inf(1))
+	if !hit.Hit {
+		t.Fatal("expected a hit")
+	}
+
+	if math.Abs(hit.Tangent.Length()-1) > 1e-9 || math.Abs(vec3.Dot(hit.Tangent, hit.Normal)) > 1e-9 {
+		t.Errorf("Tangent = %v, Normal = %v", hit.Tangent, hit.Normal)
+	}
+	if math.Abs(hit.Bitangent.Length()-1) > 1e-9 || math.Abs(vec3.Dot(hit.Bitangent, hit.Normal)) > 1e-9 ||
+		math.Abs(vec3.Dot(hit.Bitangent, hit.Tangent)) > 1e-9 {
+		t.Errorf("Bitangent = %v, Tangent = %v, Normal = %v", hit.Bitangent, hit.Tangent, hit.Normal)
+	}
+}
+
+func TestInstance_SharesGeometry(t *testing.T) {
+	polygon := NewPolygon(0, twoTriangles())
+
+	a, _ := NewInstance(1, polygon, transform.Translate(vec3.Vec3{X: -10}))
+	b, _ := NewInstance(2, polygon, transform.Translate(vec3.Vec3{X: 10}))
+
+	if &a.Geometry.(Polygon).Triangles[0] != &b.Geometry.(Polygon).Triangles[0] {
+		t.Error("instances copied the triangles of their polygon")
+	}
+}
+
+func TestInstance_SingularTransform(t *testing.T) {
+	if _, ok := NewInstance(0, Sphere{Radius: 1}, transform.Scale(vec3.Vec3{X: 1, Y: 0, Z: 1})); ok {
+		t.Error("NewInstance() accepted a singular transform")
+	}
+}
+
+func twoTriangles() []Triangle {
+	return []Triangle{
+		NewTriangle(vec3.Vec3{}, vec3.Vec3{X: 1}, vec3.Vec3{Y: 1}),
+		NewTriangle(vec3.Vec3{Z: 1}, vec3.Vec3{X: 1, Z: 1}, vec3.Vec3{Y: 1, Z: 1}),
+	}
+}
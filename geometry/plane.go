@@ -0,0 +1,74 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+)
+
+// An infinite plane through Point, facing Normal.
+// Its bounding box is infinite, so accelerators test it against every ray.
+type Plane struct {
+	Id     uint32
+	Point  vec3.Vec3
+	Normal vec3.Vec3
+}
+
+// returns the distance along r at which it crosses the plane
+func (plane Plane) intersect(r *ray.Ray) (float64, bool) {
+	const EPSILON = 0.0000001
+
+	denominator := vec3.Dot(r.Direction, plane.Normal)
+	if denominator > -EPSILON && denominator < EPSILON {
+		return 0, false // ray is parallel to plane
+	}
+
+	return vec3.Dot(vec3.Sub(plane.Point, r.Origin), plane.Normal) / denominator, true
+}
+
+func (plane Plane) Hit(r *ray.Ray, minDistance float64, maxDistance float64) HitRecord {
+	distance, hit := plane.intersect(r)
+	if !hit || distance < minDistance || distance > maxDistance {
+		return HitRecord{Hit: false}
+	}
+
+	normal, frontFace := faceNormal(r, plane.Normal.Normalized())
+
+	return HitRecord{Hit: true, Distance: distance, Point: r.At(distance), Normal: normal, GeometricNormal: normal, FrontFace: frontFace}
+}
+
+func (plane Plane) Occludes(r *ray.Ray, minDistance float64, maxDistance float64) bool {
+	distance, hit := plane.intersect(r)
+	return hit && distance >= minDistance && distance <= maxDistance
+}
+
+func (plane Plane) AABBIntersections(aabb AABB) []Geometry {
+	if plane.IntersectsAABB(aabb) {
+		intersections := make([]Geometry, 1)
+		intersections[0] = plane
+		return intersections
+	}
+
+	return nil
+}
+
+// the plane intersects the box when the box's projected radius onto the
+// normal reaches the plane from the box center
+func (plane Plane) IntersectsAABB(aabb AABB) bool {
+	if !aabb.IsBounded() {
+		return !aabb.IsEmpty()
+	}
+
+	center := aabb.Centroid()
+	extents := vec3.MultiplyScalar(vec3.Sub(aabb.Max, aabb.Min), 0.5)
+	r := extents.X*math.Abs(plane.Normal.X) + extents.Y*math.Abs(plane.Normal.Y) + extents.Z*math.Abs(plane.Normal.Z)
+	return math.Abs(vec3.Dot(plane.Normal, vec3.Sub(center, plane.Point))) <= r
+}
+
+func (plane Plane) BoundingBox() AABB {
+	return InfiniteAABB()
+}
+
+func (plane Plane) GetId() uint32 {
+	return plane.Id
+}
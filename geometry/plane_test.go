@@ -0,0 +1,114 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+	"testing"
+)
+
+func TestPlane_Hit(t *testing.T) {
+	// not normalized, Hit normalizes it
+	plane := Plane{Point: vec3.Vec3{Y: -1}, Normal: vec3.Vec3{Y: 2}}
+
+	tests := []struct {
+		name      string
+		ray       *ray.Ray
+		want      bool
+		distance  float64
+		normal    vec3.Vec3
+		frontFace bool
+	}{
+		{
+			name:      "From above",
+			ray:       ray.New(vec3.Vec3{X: 3, Y: 1, Z: -2}, vec3.Vec3{Y: -1}),
+			want:      true,
+			distance:  2,
+			normal:    vec3.Vec3{Y: 1},
+			frontFace: true,
+		},
+		{
+			name:      "From below, the normal faces the ray",
+			ray:       ray.New(vec3.Vec3{Y: -3}, vec3.Vec3{Y: 1}),
+			want:      true,
+			distance:  2,
+			normal:    vec3.Vec3{Y: -1},
+			frontFace: false,
+		},
+		{
+			name:      "At an angle",
+			ray:       ray.New(vec3.Vec3{Y: 1}, vec3.Vec3{X: 1, Y: -1}),
+			want:      true,
+			distance:  2,
+			normal:    vec3.Vec3{Y: 1},
+			frontFace: true,
+		},
+		{
+			name: "Pointing away",
+			ray:  ray.New(vec3.Vec3{Y: 1}, vec3.Vec3{Y: 1}),
+			want: false,
+		},
+		{
+			name: "Parallel above",
+			ray:  ray.New(vec3.Vec3{Y: 1}, vec3.Vec3{X: 1, Z: 1}),
+			want: false,
+		},
+		{
+			name: "Parallel in the plane",
+			ray:  ray.New(vec3.Vec3{Y: -1}, vec3.Vec3{X: 1}),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := plane.Hit(tt.ray, 0, math.Inf(1))
+			if record.Hit != tt.want {
+				t.Fatalf("Hit() = %v, want %v", record.Hit, tt.want)
+			}
+			if plane.Occludes(tt.ray, 0, math.Inf(1)) != tt.want {
+				t.Errorf("Occludes() = %v, want %v", !tt.want, tt.want)
+			}
+			if !tt.want {
+				return
+			}
+
+			if record.Distance != tt.distance {
+				t.Errorf("Distance = %v, want %v", record.Distance, tt.distance)
+			}
+			if record.Point.Y != -1 {
+				t.Errorf("Point = %v, not on the plane", record.Point)
+			}
+			if record.Normal != tt.normal || record.FrontFace != tt.frontFace {
+				t.Errorf("Normal = %v, FrontFace = %v, want %v, %v", record.Normal, record.FrontFace, tt.normal, tt.frontFace)
+			}
+		})
+	}
+}
+
+func TestPlane_HitRespectsDistanceRange(t *testing.T) {
+	plane := Plane{Normal: vec3.Vec3{Z: 1}}
+	r := ray.New(vec3.Vec3{Z: 5}, vec3.Vec3{Z: -1})
+
+	if !plane.Hit(r, 0, 5).Hit || !plane.Occludes(r, 0, 5) {
+		t.Error("missed the plane at the far end of the range")
+	}
+	if plane.Hit(r, 0, 4.9).Hit || plane.Occludes(r, 0, 4.9) {
+		t.Error("hit the plane beyond maxDistance")
+	}
+	if plane.Hit(r, 5.1, 10).Hit || plane.Occludes(r, 5.1, 10) {
+		t.Error("hit the plane before minDistance")
+	}
+}
+
+func TestPlane_BoundingBox(t *testing.T) {
+	plane := Plane{Point: vec3.Vec3{X: 1, Y: 2, Z: 3}, Normal: vec3.Vec3{X: 1, Y: 1}}
+
+	box := plane.BoundingBox()
+	if box != InfiniteAABB() {
+		t.Errorf("BoundingBox() = %v, want InfiniteAABB()", box)
+	}
+	if box.IsBounded() {
+		t.Error("an infinite plane has bounds")
+	}
+}
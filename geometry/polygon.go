@@ -5,18 +5,69 @@ import "goraytracer/ray"
 type Polygon struct {
 	Id        uint32
 	Triangles []Triangle
+
+	// built by NewPolygon. Polygons declared as literals fall back to testing every triangle.
+	bvh BVH
+}
+
+// NewPolygon builds a polygon along with a BVH over its triangles,
+// so it can be hit efficiently on its own rather than only through a scene accelerator.
+func NewPolygon(id uint32, triangles []Triangle) Polygon {
+	bounds := make([]AABB, len(triangles))
+	for i, triangle := range triangles {
+		bounds[i] = triangle.BoundingBox()
+	}
+
+	return Polygon{Id: id, Triangles: triangles, bvh: BuildBVH(bounds)}
 }
 
+// Hit returns the nearest triangle intersection
 func (polygon Polygon) Hit(r *ray.Ray, minDistance float64, maxDistance float64) HitRecord {
+	closest := HitRecord{Hit: false}
 
-	for _, triangle := range polygon.Triangles {
-		hit := triangle.Hit(r, minDistance, maxDistance)
-		if hit.Hit {
-			return hit
+	if len(polygon.bvh.Nodes) == 0 {
+		for _, triangle := range polygon.Triangles {
+			hit := triangle.Hit(r, minDistance, maxDistance)
+			if hit.Hit {
+				maxDistance = hit.Distance
+				closest = hit
+			}
+		}
+		return closest
+	}
+
+	polygon.bvh.Traverse(r, minDistance, maxDistance, nil, func(leaf *BVHNode) float64 {
+		for _, index := range polygon.bvh.Indices[leaf.First : leaf.First+leaf.Count] {
+			hit := polygon.Triangles[index].Hit(r, minDistance, maxDistance)
+			if hit.Hit {
+				maxDistance = hit.Distance
+				closest = hit
+			}
+		}
+		return maxDistance
+	})
+
+	return closest
+}
+
+func (polygon Polygon) Occludes(r *ray.Ray, minDistance float64, maxDistance float64) bool {
+	if len(polygon.bvh.Nodes) == 0 {
+		for _, triangle := range polygon.Triangles {
+			if triangle.Occludes(r, minDistance, maxDistance) {
+				return true
+			}
 		}
+		return false
 	}
 
-	return HitRecord{Hit: false}
+	return polygon.bvh.TraverseAny(r, minDistance, maxDistance, func(leaf *BVHNode) bool {
+		for _, index := range polygon.bvh.Indices[leaf.First : leaf.First+leaf.Count] {
+			if polygon.Triangles[index].Occludes(r, minDistance, maxDistance) {
+				return true
+			}
+		}
+		return false
+	})
 }
 
 func (polygon Polygon) AABBIntersections(aabb AABB) []Geometry {
@@ -39,6 +90,18 @@ func (polygon Polygon) IntersectsAABB(aabb AABB) bool {
 	return false
 }
 
+func (polygon Polygon) BoundingBox() AABB {
+	if len(polygon.bvh.Nodes) > 0 {
+		return polygon.bvh.Nodes[0].Aabb
+	}
+
+	bounds := EmptyAABB()
+	for _, triangle := range polygon.Triangles {
+		bounds = Union(bounds, triangle.BoundingBox())
+	}
+	return bounds
+}
+
 func (polygon Polygon) GetId() uint32 {
 	return polygon.Id
 }
@@ -0,0 +1,84 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func randomTriangles(count int, random *rand.Rand) []Triangle {
+	randomPoint := func() vec3.Vec3 {
+		return vec3.Vec3{X: random.Float64()*10 - 5, Y: random.Float64()*10 - 5, Z: random.Float64()*10 - 5}
+	}
+
+	triangles := make([]Triangle, count)
+	for i := range triangles {
+		p1 := randomPoint()
+		p2 := vec3.Add(p1, vec3.RandomInUnitSphere(random))
+		p3 := vec3.Add(p1, vec3.RandomInUnitSphere(random))
+		triangles[i] = NewTriangle(p1, p2, p3)
+		triangles[i].Id = uint32(i)
+	}
+	return triangles
+}
+
+func TestPolygon_HitReturnsNearestTriangle(t *testing.T) {
+	// the far triangle comes first, which Hit used to return
+	far := NewTriangle(vec3.Vec3{X: -1, Y: -1, Z: -10}, vec3.Vec3{X: 1, Y: -1, Z: -10}, vec3.Vec3{Y: 1, Z: -10})
+	near := NewTriangle(vec3.Vec3{X: -1, Y: -1, Z: -5}, vec3.Vec3{X: 1, Y: -1, Z: -5}, vec3.Vec3{Y: 1, Z: -5})
+	triangles := []Triangle{far, near}
+
+	r := ray.New(vec3.Vec3{}, vec3.Vec3{Z: -1})
+
+	tests := []struct {
+		name    string
+		polygon Polygon
+	}{
+		{name: "literal polygon", polygon: Polygon{Triangles: triangles}},
+		{name: "polygon with bvh", polygon: NewPolygon(0, triangles)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.polygon.Hit(r, 0, math.Inf(1)); got.Distance != 5 {
+				t.Errorf("Hit() distance = %v, want %v", got.Distance, 5)
+			}
+		})
+	}
+}
+
+func TestPolygon_BVHMatchesLinearSearch(t *testing.T) {
+	random := rand.New(rand.NewSource(0))
+	triangles := randomTriangles(500, random)
+
+	literal := Polygon{Triangles: triangles}
+	polygon := NewPolygon(0, triangles)
+
+	for i := 0; i < 1000; i++ {
+		origin := vec3.MultiplyScalar(vec3.RandomInUnitSphere(random).Normalized(), 20)
+		target := vec3.MultiplyScalar(vec3.RandomInUnitSphere(random), 5)
+		r := ray.New(origin, vec3.Sub(target, origin).Normalized())
+
+		expected := literal.Hit(r, .001, math.Inf(1))
+		got := polygon.Hit(r, .001, math.Inf(1))
+
+		if got != expected {
+			t.Fatalf("ray %d: Hit() = %v, want %v", i, got, expected)
+		}
+		if polygon.Occludes(r, .001, math.Inf(1)) != expected.Hit {
+			t.Fatalf("ray %d: Occludes() = %v, want %v", i, !expected.Hit, expected.Hit)
+		}
+	}
+}
+
+func BenchmarkPolygon_Hit(b *testing.B) {
+	random := rand.New(rand.NewSource(0))
+	polygon := NewPolygon(0, randomTriangles(5000, random))
+	r := ray.New(vec3.Vec3{Z: 20}, vec3.Vec3{Z: -1})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		polygon.Hit(r, .001, math.Inf(1))
+	}
+}
@@ -62,17 +62,43 @@ func (s Sphere) Hit(r *ray.Ray, minDistance float64, maxDistance float64) HitRec
 	point := r.At(distance)
 	u, v := s.GetUV(point)
 	outwardNormal := vec3.MultiplyScalar(vec3.Sub(point, s.Center), 1.0/s.Radius).Normalized()
-	isFrontFace := vec3.Dot(r.Direction, outwardNormal) < 0
+	normal, frontFace := faceNormal(r, outwardNormal)
 
-	var normal vec3.Vec3
+	// U grows around the Y axis and V towards +Y, the frame vanishes at the poles
+	var tangent, bitangent vec3.Vec3
+	if around := (vec3.Vec3{X: outwardNormal.Z, Z: -outwardNormal.X}); !around.NearZero() {
+		tangent = around.Normalized()
+		bitangent = vec3.Cross(outwardNormal, tangent)
+	}
+
+	return HitRecord{
+		Hit:             true,
+		Distance:        distance,
+		Point:           point,
+		Normal:          normal,
+		GeometricNormal: normal,
+		Tangent:         tangent,
+		Bitangent:       bitangent,
+		U:               u,
+		V:               v,
+		FrontFace:       frontFace,
+	}
+}
 
-	if isFrontFace {
-		normal = outwardNormal
-	} else {
-		normal = vec3.MultiplyScalar(outwardNormal, -1.0)
+func (s Sphere) Occludes(r *ray.Ray, minDistance float64, maxDistance float64) bool {
+	originToCenter := vec3.Sub(r.Origin, s.Center)
+	a := vec3.Dot(r.Direction, r.Direction)
+	halfB := vec3.Dot(originToCenter, r.Direction)
+	c := vec3.Dot(originToCenter, originToCenter) - (s.Radius * s.Radius)
+	discriminant := halfB*halfB - (a * c)
+	if discriminant < 0 {
+		return false
 	}
 
-	return HitRecord{Hit: true, Distance: distance, Point: point, Normal: normal, U: u, V: v}
+	sqrtD := math.Sqrt(discriminant)
+	near := (-halfB - sqrtD) / a
+	far := (-halfB + sqrtD) / a
+	return (near >= minDistance && near <= maxDistance) || (far >= minDistance && far <= maxDistance)
 }
 
 func (s Sphere) AABBIntersections(aabb AABB) []Geometry {
@@ -98,6 +124,11 @@ func (s Sphere) IntersectsAABB(aabb AABB) bool {
 	return distanceSquared <= s.Radius*s.Radius
 }
 
+func (s Sphere) BoundingBox() AABB {
+	extent := vec3.Vec3{X: s.Radius, Y: s.Radius, Z: s.Radius}
+	return AABB{Min: vec3.Sub(s.Center, extent), Max: vec3.Add(s.Center, extent)}
+}
+
 func (s Sphere) GetUV(point vec3.Vec3) (u float64, v float64) {
 	P := vec3.Sub(s.Center, point).Normalized()
 	u = 0.5 + (math.Atan2(P.X, P.Z) / (2.0 * math.Pi))
@@ -108,5 +139,3 @@ func (s Sphere) GetUV(point vec3.Vec3) (u float64, v float64) {
 func (s Sphere) GetId() uint32 {
 	return s.Id
 }
-
-//https://thebookofshaders.com/13/
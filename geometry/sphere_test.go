@@ -2,7 +2,9 @@ package geometry
 
 import (
 	"fmt"
+	"goraytracer/ray"
 	"goraytracer/vec3"
+	"math"
 	"testing"
 )
 
@@ -24,25 +26,25 @@ func TestSphere_GetUV(t *testing.T) {
 	}{
 		{
 			name:  "cartesian coordinates 0,0,1",
-			args:  args{point: vec3.Vec3{0, 0, 1}.Normalized()},
+			args:  args{point: vec3.Vec3{X: 0, Y: 0, Z: 1}.Normalized()},
 			wantU: 1.0,
 			wantV: 0.5,
 		},
 		{
 			name:  "cartesian coordinates 1,0,0",
-			args:  args{point: vec3.Vec3{1, 0, 0}.Normalized()},
+			args:  args{point: vec3.Vec3{X: 1, Y: 0, Z: 0}.Normalized()},
 			wantU: 0.25,
 			wantV: 0.5,
 		},
 		{
 			name:  "cartesian coordinates 0,0,-1",
-			args:  args{point: vec3.Vec3{0, 0, -1}.Normalized()},
+			args:  args{point: vec3.Vec3{X: 0, Y: 0, Z: -1}.Normalized()},
 			wantU: 0.5,
 			wantV: 0.5,
 		},
 		{
 			name:  "cartesian coordinates -1,0,0",
-			args:  args{point: vec3.Vec3{-1, 0, 0}.Normalized()},
+			args:  args{point: vec3.Vec3{X: -1, Y: 0, Z: 0}.Normalized()},
 			wantU: 0.75,
 			wantV: 0.5,
 		},
@@ -60,3 +62,86 @@ func TestSphere_GetUV(t *testing.T) {
 		})
 	}
 }
+
+func TestSphere_Occludes(t *testing.T) {
+	sphere := Sphere{
+		Id:     0,
+		Center: vec3.Vec3{Z: -5},
+		Radius: 1,
+	}
+	r := ray.New(vec3.Vec3{}, vec3.Vec3{Z: -1})
+
+	tests := []struct {
+		name        string
+		minDistance float64
+		maxDistance float64
+		want        bool
+	}{
+		{name: "both intersections in range", minDistance: 0, maxDistance: 10, want: true},
+		{name: "sphere beyond max distance", minDistance: 0, maxDistance: 3, want: false},
+		{name: "only the far intersection in range", minDistance: 5, maxDistance: 10, want: true},
+		{name: "sphere behind min distance", minDistance: 7, maxDistance: 10, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sphere.Occludes(r, tt.minDistance, tt.maxDistance); got != tt.want {
+				t.Errorf("Occludes() = %v, want %v", got, tt.want)
+			}
+			if got := sphere.Hit(r, tt.minDistance, tt.maxDistance).Hit; got != tt.want {
+				t.Errorf("Hit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSphere_HitFrontFace(t *testing.T) {
+	sphere := Sphere{
+		Id:     0,
+		Center: vec3.Vec3{Z: -5},
+		Radius: 1,
+	}
+
+	tests := []struct {
+		name       string
+		r          *ray.Ray
+		wantFront  bool
+		wantNormal vec3.Vec3
+	}{
+		{name: "from outside", r: ray.New(vec3.Vec3{}, vec3.Vec3{Z: -1}), wantFront: true, wantNormal: vec3.Vec3{Z: 1}},
+		{name: "from inside", r: ray.New(vec3.Vec3{Z: -5}, vec3.Vec3{Z: -1}), wantFront: false, wantNormal: vec3.Vec3{Z: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hit := sphere.Hit(tt.r, 0, 10)
+			if !hit.Hit || hit.FrontFace != tt.wantFront || hit.Normal != tt.wantNormal {
+				t.Errorf("Hit() = %+v, want FrontFace %v and Normal %v", hit, tt.wantFront, tt.wantNormal)
+			}
+		})
+	}
+}
+
+func TestSphere_HitTangentFollowsU(t *testing.T) {
+	sphere := Sphere{Center: vec3.Vec3{X: 1, Y: 2, Z: 3}, Radius: 2}
+
+	for _, direction := range []vec3.Vec3{{X: 1}, {Z: -1}, {X: 1, Y: 1, Z: 1}, {X: -1, Y: -.5, Z: .2}} {
+		origin := vec3.Add(sphere.Center, vec3.MultiplyScalar(direction.Normalized(), 10))
+		hit := sphere.Hit(ray.New(origin, vec3.Sub(sphere.Center, origin)), 0, 20)
+
+		if math.Abs(hit.Tangent.Length()-1) > 1e-9 || math.Abs(vec3.Dot(hit.Tangent, hit.Normal)) > 1e-9 {
+			t.Errorf("%v: Tangent = %v, Normal = %v", direction, hit.Tangent, hit.Normal)
+		}
+
+		// stepping along the tangent increases U, along the bitangent V
+		step := vec3.Add(hit.Point, vec3.MultiplyScalar(hit.Tangent, .001))
+		if u, _ := sphere.GetUV(step); u <= hit.U {
+			t.Errorf("%v: U %v doesn't grow along the tangent, %v", direction, hit.U, u)
+		}
+		step = vec3.Add(hit.Point, vec3.MultiplyScalar(hit.Bitangent, .001))
+		if _, v := sphere.GetUV(step); v <= hit.V {
+			t.Errorf("%v: V %v doesn't grow along the bitangent, %v", direction, hit.V, v)
+		}
+		if math.Abs(vec3.Dot(hit.Tangent, hit.Bitangent)) > 1e-9 || math.Abs(vec3.Dot(hit.Normal, hit.Bitangent)) > 1e-9 {
+			t.Errorf("%v: the frame isn't orthogonal", direction)
+		}
+	}
+}
@@ -3,14 +3,26 @@ package geometry
 import (
 	"goraytracer/ray"
 	"goraytracer/vec3"
+	"math"
 )
 
+// TexCoord is a texture coordinate, with (0, 0) at the bottom left of a texture.
+type TexCoord struct {
+	U, V float64
+}
+
 type Triangle struct {
 	Id     uint32
 	P1     vec3.Vec3
 	P2     vec3.Vec3
 	P3     vec3.Vec3
 	Normal vec3.Vec3
+
+	// Optional per vertex attributes, in the order of P1, P2 and P3, interpolated at the hit.
+	// They are pointers so triangles without them stay small.
+	UVs      *[3]TexCoord
+	Normals  *[3]vec3.Vec3
+	Tangents *[3]vec3.Vec3
 }
 
 func edges(p1 vec3.Vec3, p2 vec3.Vec3, p3 vec3.Vec3) (vec3.Vec3, vec3.Vec3) {
@@ -32,53 +44,161 @@ func NewTriangle(p1 vec3.Vec3, p2 vec3.Vec3, p3 vec3.Vec3) Triangle {
 	}
 }
 
-func (triangle Triangle) Hit(ray *ray.Ray, minDistance float64, maxDistance float64) HitRecord {
-	// ray - triangle intersection
-	// https://en.wikipedia.org/wiki/M%C3%B6ller%E2%80%93Trumbore_intersection_algorithm
-
+// intersectTriangle returns the distance along the ray to the triangle p1 p2 p3
+// and the barycentric coordinates of the hit, weighting p2 by u and p3 by v.
+// https://en.wikipedia.org/wiki/M%C3%B6ller%E2%80%93Trumbore_intersection_algorithm
+func intersectTriangle(ray *ray.Ray, p1 vec3.Vec3, p2 vec3.Vec3, p3 vec3.Vec3, minDistance float64, maxDistance float64) (t float64, u float64, v float64, hit bool) {
 	const EPSILON = 0.0000001
 
-	edge1, edge2 := edges(triangle.P1, triangle.P2, triangle.P3)
+	edge1, edge2 := edges(p1, p2, p3)
 
 	h := vec3.Cross(ray.Direction, edge2)
 	a := vec3.Dot(edge1, h)
 
 	if a > -EPSILON && a < EPSILON {
-		return HitRecord{Hit: false} // ray is parallel to triangle
+		return 0, 0, 0, false // ray is parallel to triangle
 	}
 
 	f := 1.0 / a
-	s := vec3.Sub(ray.Origin, triangle.P1)
-	u := f * vec3.Dot(s, h)
+	s := vec3.Sub(ray.Origin, p1)
+	u = f * vec3.Dot(s, h)
 
 	if u < 0.0 || u > 1.0 {
-		return HitRecord{Hit: false}
+		return 0, 0, 0, false
 	}
 
 	q := vec3.Cross(s, edge1)
-	v := f * vec3.Dot(ray.Direction, q)
+	v = f * vec3.Dot(ray.Direction, q)
 
 	if v < 0.0 || u+v > 1.0 {
-		return HitRecord{Hit: false}
+		return 0, 0, 0, false
 	}
 
-	t := f * vec3.Dot(edge2, q)
+	t = f * vec3.Dot(edge2, q)
 	if t > EPSILON && t >= minDistance && t <= maxDistance {
-		return HitRecord{
-			Hit:      true,
-			Distance: t,
-			Point:    ray.At(t),
-			Normal:   triangle.Normal,
+		return t, u, v, true
+	}
+
+	return 0, 0, 0, false
+}
+
+// interpolates three vertex values with barycentric coordinates
+func barycentric(a vec3.Vec3, b vec3.Vec3, c vec3.Vec3, u float64, v float64) vec3.Vec3 {
+	return vec3.Add(vec3.MultiplyScalar(a, 1-u-v), vec3.Add(vec3.MultiplyScalar(b, u), vec3.MultiplyScalar(c, v)))
+}
+
+// returns the directions in which the texture's U and V coordinates grow across the triangle,
+// or false when the UVs are degenerate
+func uvDerivatives(edge1 vec3.Vec3, edge2 vec3.Vec3, uvs *[3]TexCoord) (vec3.Vec3, vec3.Vec3, bool) {
+	du1, dv1 := uvs[1].U-uvs[0].U, uvs[1].V-uvs[0].V
+	du2, dv2 := uvs[2].U-uvs[0].U, uvs[2].V-uvs[0].V
+
+	determinant := du1*dv2 - du2*dv1
+	if determinant == 0 {
+		return vec3.Vec3{}, vec3.Vec3{}, false
+	}
+
+	dpdu := vec3.MultiplyScalar(vec3.Sub(vec3.MultiplyScalar(edge1, dv2), vec3.MultiplyScalar(edge2, dv1)), 1/determinant)
+	dpdv := vec3.MultiplyScalar(vec3.Sub(vec3.MultiplyScalar(edge2, du1), vec3.MultiplyScalar(edge1, du2)), 1/determinant)
+	return dpdu, dpdv, true
+}
+
+// orthogonalize removes the normal component of tangent and normalizes it
+func orthogonalize(tangent vec3.Vec3, normal vec3.Vec3) vec3.Vec3 {
+	tangent = vec3.Sub(tangent, vec3.MultiplyScalar(normal, vec3.Dot(tangent, normal)))
+	if tangent.NearZero() {
+		return vec3.Vec3{}
+	}
+	return tangent.Normalized()
+}
+
+// Hit fills in the shading attributes of the hit, see shadeTriangle.
+func (triangle Triangle) Hit(ray *ray.Ray, minDistance float64, maxDistance float64) HitRecord {
+	t, u, v, hit := intersectTriangle(ray, triangle.P1, triangle.P2, triangle.P3, minDistance, maxDistance)
+	if !hit {
+		return HitRecord{Hit: false}
+	}
+
+	// the winding normal defines the front
+	geometricNormal, frontFace := faceNormal(ray, triangle.Normal)
+
+	hitRecord := HitRecord{
+		Hit:             true,
+		Distance:        t,
+		Point:           ray.At(t),
+		Normal:          geometricNormal,
+		GeometricNormal: geometricNormal,
+		U:               u,
+		V:               v,
+		FrontFace:       frontFace,
+	}
+
+	edge1, edge2 := edges(triangle.P1, triangle.P2, triangle.P3)
+	shadeTriangle(&hitRecord, edge1, edge2, u, v, triangle.Normals, triangle.UVs, triangle.Tangents, nil)
+
+	return hitRecord
+}
+
+// shadeTriangle interpolates the optional vertex attributes of a triangle at barycentrics u, v
+// into a hit whose geometric normal is already set.
+// U and V become the interpolated texture coordinates, or stay the barycentric coordinates
+// when there are no UVs. Normal becomes the interpolated vertex normal, turned to the same side
+// as GeometricNormal. Tangent follows the vertex tangents, or the direction U grows in,
+// and Bitangent completes the frame on the side V grows in.
+// With tangent signs, Bitangent is sign·(normal × tangent) instead, where the normal is the
+// vertex normal before it is turned toward the ray, as glTF defines it.
+func shadeTriangle(hitRecord *HitRecord, edge1 vec3.Vec3, edge2 vec3.Vec3, u float64, v float64,
+	normals *[3]vec3.Vec3, uvs *[3]TexCoord, tangents *[3]vec3.Vec3, tangentSigns *[3]float64) {
+
+	outward := vec3.Cross(edge1, edge2)
+	if normals != nil {
+		shading := barycentric(normals[0], normals[1], normals[2], u, v)
+		if !shading.NearZero() {
+			shading = shading.Normalized()
+			outward = shading
+			if vec3.Dot(shading, hitRecord.GeometricNormal) < 0 {
+				shading = vec3.MultiplyScalar(shading, -1)
+			}
+			hitRecord.Normal = shading
 		}
 	}
 
-	return HitRecord{Hit: false}
+	if uvs != nil {
+		hitRecord.U = (1-u-v)*uvs[0].U + u*uvs[1].U + v*uvs[2].U
+		hitRecord.V = (1-u-v)*uvs[0].V + u*uvs[1].V + v*uvs[2].V
+	}
+
+	var dpdu, dpdv vec3.Vec3
+	hasDerivatives := false
+	if uvs != nil {
+		dpdu, dpdv, hasDerivatives = uvDerivatives(edge1, edge2, uvs)
+	}
+
+	if tangents != nil {
+		hitRecord.Tangent = orthogonalize(barycentric(tangents[0], tangents[1], tangents[2], u, v), hitRecord.Normal)
+	} else if hasDerivatives {
+		hitRecord.Tangent = orthogonalize(dpdu, hitRecord.Normal)
+	}
+
+	if hitRecord.Tangent == (vec3.Vec3{}) {
+		return
+	}
+
+	// mirrored UVs flip the bitangent relative to the normal and tangent
+	hitRecord.Bitangent = vec3.Cross(hitRecord.Normal, hitRecord.Tangent)
+	if tangentSigns != nil {
+		sign := (1-u-v)*tangentSigns[0] + u*tangentSigns[1] + v*tangentSigns[2]
+		if (sign < 0) != (vec3.Dot(hitRecord.Normal, outward) < 0) {
+			hitRecord.Bitangent = vec3.MultiplyScalar(hitRecord.Bitangent, -1)
+		}
+	} else if hasDerivatives && vec3.Dot(hitRecord.Bitangent, dpdv) < 0 {
+		hitRecord.Bitangent = vec3.MultiplyScalar(hitRecord.Bitangent, -1)
+	}
 }
 
-func pointInAABB(point vec3.Vec3, aabb AABB) bool {
-	return point.X >= aabb.Min.X && point.X <= aabb.Max.X &&
-		point.Y >= aabb.Min.Y && point.Y <= aabb.Max.Y &&
-		point.Z >= aabb.Min.Z && point.Z <= aabb.Max.Z
+func (triangle Triangle) Occludes(ray *ray.Ray, minDistance float64, maxDistance float64) bool {
+	_, _, _, hit := intersectTriangle(ray, triangle.P1, triangle.P2, triangle.P3, minDistance, maxDistance)
+	return hit
 }
 
 func (triangle Triangle) AABBIntersections(aabb AABB) []Geometry {
@@ -91,11 +211,62 @@ func (triangle Triangle) AABBIntersections(aabb AABB) []Geometry {
 	return nil
 }
 
+// reports whether the triangle and a box centered at the origin project onto
+// disjoint intervals along axis. Touching intervals are not separated.
+func separatedOnAxis(axis vec3.Vec3, v0 vec3.Vec3, v1 vec3.Vec3, v2 vec3.Vec3, extents vec3.Vec3) bool {
+	p0 := vec3.Dot(v0, axis)
+	p1 := vec3.Dot(v1, axis)
+	p2 := vec3.Dot(v2, axis)
+	r := extents.X*math.Abs(axis.X) + extents.Y*math.Abs(axis.Y) + extents.Z*math.Abs(axis.Z)
+	return math.Min(p0, math.Min(p1, p2)) > r || math.Max(p0, math.Max(p1, p2)) < -r
+}
+
+// triangle - aabb overlap using the separating axis theorem
+// https://fileadmin.cs.lth.se/cs/Personal/Tomas_Akenine-Moller/code/tribox_tam.pdf
 func (triangle Triangle) IntersectsAABB(aabb AABB) bool {
-	// TODO: this is wrong.
-	return pointInAABB(triangle.P1, aabb) ||
-		pointInAABB(triangle.P2, aabb) ||
-		pointInAABB(triangle.P3, aabb)
+	// move the box to the origin
+	center := aabb.Centroid()
+	extents := vec3.MultiplyScalar(vec3.Sub(aabb.Max, aabb.Min), 0.5)
+
+	v0 := vec3.Sub(triangle.P1, center)
+	v1 := vec3.Sub(triangle.P2, center)
+	v2 := vec3.Sub(triangle.P3, center)
+
+	// the box face normals, equivalent to testing the triangle's bounds against the box
+	if math.Min(v0.X, math.Min(v1.X, v2.X)) > extents.X || math.Max(v0.X, math.Max(v1.X, v2.X)) < -extents.X ||
+		math.Min(v0.Y, math.Min(v1.Y, v2.Y)) > extents.Y || math.Max(v0.Y, math.Max(v1.Y, v2.Y)) < -extents.Y ||
+		math.Min(v0.Z, math.Min(v1.Z, v2.Z)) > extents.Z || math.Max(v0.Z, math.Max(v1.Z, v2.Z)) < -extents.Z {
+		return false
+	}
+
+	// the triangle's normal.
+	// degenerate triangles have a zero normal, which never separates anything.
+	e0 := vec3.Sub(v1, v0)
+	e1 := vec3.Sub(v2, v1)
+	e2 := vec3.Sub(v0, v2)
+
+	if separatedOnAxis(vec3.Cross(e0, e1), v0, v1, v2, extents) {
+		return false
+	}
+
+	// the nine cross products of the box axes and the triangle edges
+	boxAxes := [3]vec3.Vec3{{X: 1}, {Y: 1}, {Z: 1}}
+	for _, edge := range [3]vec3.Vec3{e0, e1, e2} {
+		for _, boxAxis := range boxAxes {
+			if separatedOnAxis(vec3.Cross(boxAxis, edge), v0, v1, v2, extents) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (triangle Triangle) BoundingBox() AABB {
+	return AABB{
+		Min: vec3.Min(triangle.P1, vec3.Min(triangle.P2, triangle.P3)),
+		Max: vec3.Max(triangle.P1, vec3.Max(triangle.P2, triangle.P3)),
+	}
 }
 
 func (triangle Triangle) GetId() uint32 {
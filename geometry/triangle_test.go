@@ -0,0 +1,235 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestTriangle_IntersectsAABB(t *testing.T) {
+
+	testAABB := AABB{
+		Min: vec3.Vec3{X: -1, Y: -1, Z: -1},
+		Max: vec3.Vec3{X: 1, Y: 1, Z: 1},
+	}
+
+	tests := []struct {
+		name     string
+		triangle Triangle
+		want     bool
+	}{
+		{
+			name:     "Vertex inside",
+			triangle: NewTriangle(vec3.Vec3{}, vec3.Vec3{X: 5}, vec3.Vec3{Y: 5}),
+			want:     true,
+		},
+		{
+			name:     "Spans the box with no vertex inside",
+			triangle: NewTriangle(vec3.Vec3{X: -10, Y: -10}, vec3.Vec3{X: 10, Y: -10}, vec3.Vec3{Y: 10}),
+			want:     true,
+		},
+		{
+			name:     "Edge on, crossing the box with no vertex inside",
+			triangle: NewTriangle(vec3.Vec3{Y: -5, Z: -5}, vec3.Vec3{Y: 5, Z: -5}, vec3.Vec3{Z: 5}),
+			want:     true,
+		},
+		{
+			name:     "Edge on, lying in a box face",
+			triangle: NewTriangle(vec3.Vec3{X: -5, Y: 1, Z: -5}, vec3.Vec3{X: 5, Y: 1, Z: -5}, vec3.Vec3{Y: 1, Z: 5}),
+			want:     true,
+		},
+		{
+			name:     "Vertex touching a box corner",
+			triangle: NewTriangle(vec3.Vec3{X: 1, Y: 1, Z: 1}, vec3.Vec3{X: 5, Y: 1, Z: 1}, vec3.Vec3{X: 1, Y: 5, Z: 1}),
+			want:     true,
+		},
+		{
+			name:     "Separated by a box face",
+			triangle: NewTriangle(vec3.Vec3{X: 2}, vec3.Vec3{X: 3}, vec3.Vec3{X: 2, Y: 1}),
+			want:     false,
+		},
+		{
+			name:     "Separated by the triangle plane",
+			triangle: NewTriangle(vec3.Vec3{X: 3.1}, vec3.Vec3{Y: 3.1}, vec3.Vec3{Z: 3.1}),
+			want:     false,
+		},
+		{
+			name:     "Separated by an edge cross product",
+			triangle: NewTriangle(vec3.Vec3{X: 2, Y: .5}, vec3.Vec3{X: .5, Y: 2}, vec3.Vec3{X: 2, Y: 2}),
+			want:     false,
+		},
+		{
+			name:     "Degenerate: point inside",
+			triangle: NewTriangle(vec3.Vec3{X: .5}, vec3.Vec3{X: .5}, vec3.Vec3{X: .5}),
+			want:     true,
+		},
+		{
+			name:     "Degenerate: point outside",
+			triangle: NewTriangle(vec3.Vec3{X: 1.5}, vec3.Vec3{X: 1.5}, vec3.Vec3{X: 1.5}),
+			want:     false,
+		},
+		{
+			name:     "Degenerate: segment crossing the box",
+			triangle: NewTriangle(vec3.Vec3{X: -5, Y: -5, Z: -5}, vec3.Vec3{X: 5, Y: 5, Z: 5}, vec3.Vec3{}),
+			want:     true,
+		},
+		{
+			name:     "Degenerate: segment passing a corner",
+			triangle: NewTriangle(vec3.Vec3{X: 3, Y: -.5}, vec3.Vec3{X: -.5, Y: 3}, vec3.Vec3{X: 1.25, Y: 1.25}),
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.triangle.IntersectsAABB(testAABB); got != tt.want {
+				t.Errorf("IntersectsAABB() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTriangle_HitFrontFace(t *testing.T) {
+	// counter clockwise seen from +Z, so the winding normal is +Z
+	triangle := NewTriangle(vec3.Vec3{X: -1, Y: -1}, vec3.Vec3{X: 1, Y: -1}, vec3.Vec3{Y: 1})
+
+	front := triangle.Hit(ray.New(vec3.Vec3{Z: 1}, vec3.Vec3{Z: -1}), 0, 10)
+	if !front.Hit || !front.FrontFace || front.Normal != (vec3.Vec3{Z: 1}) {
+		t.Errorf("front Hit() = %+v", front)
+	}
+
+	back := triangle.Hit(ray.New(vec3.Vec3{Z: -1}, vec3.Vec3{Z: 1}), 0, 10)
+	if !back.Hit || back.FrontFace || back.Normal != (vec3.Vec3{Z: -1}) {
+		t.Errorf("back Hit() = %+v", back)
+	}
+}
+
+// a right triangle in the z = 0 plane, facing +Z
+func unitTriangle() Triangle {
+	return NewTriangle(vec3.Vec3{}, vec3.Vec3{X: 1}, vec3.Vec3{Y: 1})
+}
+
+// returns the hit of a ray straight down onto x, y
+func hitFromAbove(triangle Triangle, x float64, y float64) HitRecord {
+	return triangle.Hit(ray.New(vec3.Vec3{X: x, Y: y, Z: 1}, vec3.Vec3{Z: -1}), 0, 10)
+}
+
+func TestTriangle_HitBarycentricsWithoutUVs(t *testing.T) {
+	triangle := unitTriangle()
+
+	hit := hitFromAbove(triangle, .25, .5)
+	if !hit.Hit || hit.U != .25 || hit.V != .5 {
+		t.Errorf("Hit() = %+v, want U .25 and V .5", hit)
+	}
+	if hit.Tangent != (vec3.Vec3{}) {
+		t.Errorf("Tangent = %v without UVs", hit.Tangent)
+	}
+}
+
+func TestTriangle_HitInterpolatesUVs(t *testing.T) {
+	triangle := unitTriangle()
+	triangle.UVs = &[3]TexCoord{{U: .5, V: .5}, {U: 1, V: .5}, {U: .5, V: 1}}
+
+	tests := []struct {
+		name   string
+		x, y   float64
+		wantUV TexCoord
+	}{
+		{name: "first vertex", x: 0, y: 0, wantUV: TexCoord{U: .5, V: .5}},
+		{name: "second vertex", x: 1, y: 0, wantUV: TexCoord{U: 1, V: .5}},
+		{name: "third vertex", x: 0, y: 1, wantUV: TexCoord{U: .5, V: 1}},
+		{name: "inside", x: .2, y: .4, wantUV: TexCoord{U: .6, V: .7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hit := hitFromAbove(triangle, tt.x, tt.y)
+			if !hit.Hit || math.Abs(hit.U-tt.wantUV.U) > 1e-9 || math.Abs(hit.V-tt.wantUV.V) > 1e-9 {
+				t.Errorf("Hit() = %+v, want %v", hit, tt.wantUV)
+			}
+			// U grows along +X and V along +Y
+			if !nearlyEqual(hit.Tangent, vec3.Vec3{X: 1}) || !nearlyEqual(hit.Bitangent, vec3.Vec3{Y: 1}) {
+				t.Errorf("Tangent = %v, Bitangent = %v", hit.Tangent, hit.Bitangent)
+			}
+		})
+	}
+}
+
+func TestTriangle_HitInterpolatesNormals(t *testing.T) {
+	triangle := unitTriangle()
+	tilted := vec3.Vec3{X: 1, Z: 1}.Normalized()
+	triangle.Normals = &[3]vec3.Vec3{{Z: 1}, tilted, {Z: 1}}
+
+	if hit := hitFromAbove(triangle, 0, 0); !nearlyEqual(hit.Normal, vec3.Vec3{Z: 1}) {
+		t.Error("first vertex", hit.Normal)
+	}
+	if hit := hitFromAbove(triangle, 1, 0); !nearlyEqual(hit.Normal, tilted) {
+		t.Error("second vertex", hit.Normal)
+	}
+
+	hit := hitFromAbove(triangle, .5, 0)
+	expected := vec3.Add(vec3.Vec3{Z: 1}, tilted).Normalized()
+	if !nearlyEqual(hit.Normal, expected) || hit.GeometricNormal != (vec3.Vec3{Z: 1}) {
+		t.Error("edge midpoint", hit.Normal, hit.GeometricNormal)
+	}
+
+	// from behind, both normals turn towards the ray
+	back := triangle.Hit(ray.New(vec3.Vec3{X: .5, Z: -1}, vec3.Vec3{Z: 1}), 0, 10)
+	if back.FrontFace || !nearlyEqual(back.Normal, vec3.MultiplyScalar(expected, -1)) || back.GeometricNormal != (vec3.Vec3{Z: -1}) {
+		t.Error("back face", back.Normal, back.GeometricNormal)
+	}
+}
+
+func TestTriangle_HitOrthogonalizesTangents(t *testing.T) {
+	triangle := unitTriangle()
+	triangle.Tangents = &[3]vec3.Vec3{{X: 1, Z: 1}, {X: 1, Z: 1}, {X: 1, Z: 1}}
+
+	hit := hitFromAbove(triangle, .25, .25)
+	if !nearlyEqual(hit.Tangent, vec3.Vec3{X: 1}) {
+		t.Error(hit.Tangent)
+	}
+}
+
+func TestTriangle_OccludesAgreesWithHit(t *testing.T) {
+	random := rand.New(rand.NewSource(0))
+	triangle := NewTriangle(vec3.Vec3{X: -1, Y: -1}, vec3.Vec3{X: 1, Y: -1, Z: .5}, vec3.Vec3{Y: 1})
+
+	for i := 0; i < 10000; i++ {
+		origin := vec3.MultiplyScalar(vec3.RandomInUnitSphere(random), 4)
+		r := ray.New(origin, vec3.RandomInUnitSphere(random))
+		maxDistance := random.Float64() * 4
+
+		if triangle.Occludes(r, 0, maxDistance) != triangle.Hit(r, 0, maxDistance).Hit {
+			t.Fatal(i, r)
+		}
+	}
+}
+
+func TestTriangle_HitBitangentFollowsV(t *testing.T) {
+	tests := []struct {
+		name          string
+		uvs           [3]TexCoord
+		fromBelow     bool
+		wantBitangent vec3.Vec3
+	}{
+		{name: "front", uvs: [3]TexCoord{{U: 0, V: 0}, {U: 1, V: 0}, {U: 0, V: 1}}, wantBitangent: vec3.Vec3{Y: 1}},
+		{name: "back", uvs: [3]TexCoord{{U: 0, V: 0}, {U: 1, V: 0}, {U: 0, V: 1}}, fromBelow: true, wantBitangent: vec3.Vec3{Y: 1}},
+		{name: "mirrored", uvs: [3]TexCoord{{U: 0, V: 1}, {U: 1, V: 1}, {U: 0, V: 0}}, wantBitangent: vec3.Vec3{Y: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			triangle := unitTriangle()
+			triangle.UVs = &tt.uvs
+
+			r := ray.New(vec3.Vec3{X: .25, Y: .25, Z: 1}, vec3.Vec3{Z: -1})
+			if tt.fromBelow {
+				r = ray.New(vec3.Vec3{X: .25, Y: .25, Z: -1}, vec3.Vec3{Z: 1})
+			}
+
+			hit := triangle.Hit(r, 0, 10)
+			if !nearlyEqual(hit.Bitangent, tt.wantBitangent) || !nearlyEqual(hit.Tangent, vec3.Vec3{X: 1}) {
+				t.Errorf("Tangent = %v, Bitangent = %v, want %v", hit.Tangent, hit.Bitangent, tt.wantBitangent)
+			}
+		})
+	}
+}
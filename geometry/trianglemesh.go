@@ -0,0 +1,296 @@
+package geometry
+
+import (
+	"fmt"
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+)
+
+// A TriangleMesh is an indexed mesh: triangles reference shared vertices by index, three
+// indices per triangle, so vertex attributes are stored once and can be smoothly interpolated.
+//
+// Normals, UVs, Tangents, TangentSigns and Colors are optional. When present they hold one entry
+// per position, which Validate checks. Hit ignores attributes of any other length.
+// TangentSigns give the handedness of the tangent frame like glTF's TANGENT.w, otherwise it's
+// recovered from the UVs. Colors are carried along from scanned meshes but not interpolated at hits.
+type TriangleMesh struct {
+	Id           uint32
+	Positions    []vec3.Vec3
+	Normals      []vec3.Vec3
+	UVs          []TexCoord
+	Tangents     []vec3.Vec3
+	TangentSigns []float64
+	Colors       []vec3.Vec3
+	Indices      []uint32
+
+	bvh BVH
+}
+
+// NormalWeighting decides how much each adjacent face contributes to a vertex normal.
+type NormalWeighting int
+
+const (
+	// AngleWeighted weights faces by their angle at the vertex,
+	// so the result doesn't depend on how the surface is triangulated.
+	AngleWeighted NormalWeighting = iota
+
+	// AreaWeighted weights faces by their area, favoring large faces.
+	AreaWeighted
+)
+
+// NewTriangleMesh validates the index buffer and builds a BVH over the triangles.
+// Attributes can be added to the returned mesh afterwards.
+func NewTriangleMesh(id uint32, positions []vec3.Vec3, indices []uint32) (*TriangleMesh, error) {
+	if len(indices)%3 != 0 {
+		return nil, fmt.Errorf("geometry: %d indices don't form whole triangles", len(indices))
+	}
+	for _, index := range indices {
+		if int(index) >= len(positions) {
+			return nil, fmt.Errorf("geometry: vertex index %d out of range, the mesh has %d vertices", index, len(positions))
+		}
+	}
+
+	mesh := &TriangleMesh{Id: id, Positions: positions, Indices: indices}
+
+	bounds := make([]AABB, mesh.TriangleCount())
+	for i := range bounds {
+		bounds[i] = mesh.Triangle(i).BoundingBox()
+	}
+	mesh.bvh = BuildBVH(bounds)
+
+	return mesh, nil
+}
+
+// Validate reports attributes that don't hold one entry per position.
+func (mesh *TriangleMesh) Validate() error {
+	attributes := []struct {
+		name   string
+		length int
+	}{
+		{"normals", len(mesh.Normals)},
+		{"uvs", len(mesh.UVs)},
+		{"tangents", len(mesh.Tangents)},
+		{"tangent signs", len(mesh.TangentSigns)},
+		{"colors", len(mesh.Colors)},
+	}
+
+	for _, attribute := range attributes {
+		if attribute.length != 0 && attribute.length != len(mesh.Positions) {
+			return fmt.Errorf("geometry: %d %s for %d vertices", attribute.length, attribute.name, len(mesh.Positions))
+		}
+	}
+	return nil
+}
+
+func (mesh *TriangleMesh) TriangleCount() int {
+	return len(mesh.Indices) / 3
+}
+
+// Triangle returns the i'th triangle of the mesh as a primitive.
+func (mesh *TriangleMesh) Triangle(i int) MeshTriangle {
+	return MeshTriangle{Mesh: mesh, Index: i}
+}
+
+func (mesh *TriangleMesh) vertices(triangle int) (uint32, uint32, uint32) {
+	return mesh.Indices[3*triangle], mesh.Indices[3*triangle+1], mesh.Indices[3*triangle+2]
+}
+
+// returns the angle between the edges from corner to a and b
+func cornerAngle(corner vec3.Vec3, a vec3.Vec3, b vec3.Vec3) float64 {
+	edgeA := vec3.Sub(a, corner)
+	edgeB := vec3.Sub(b, corner)
+	if edgeA.NearZero() || edgeB.NearZero() {
+		return 0
+	}
+	cos := vec3.Dot(edgeA.Normalized(), edgeB.Normalized())
+	return math.Acos(math.Max(-1, math.Min(1, cos)))
+}
+
+// ComputeNormals replaces Normals with the weighted average of the normals of the faces
+// around each vertex. Vertices that belong to no face, or only to degenerate ones, get a zero
+// normal, which Hit ignores in favor of the face normal.
+func (mesh *TriangleMesh) ComputeNormals(weighting NormalWeighting) {
+	normals := make([]vec3.Vec3, len(mesh.Positions))
+
+	for i := 0; i < mesh.TriangleCount(); i++ {
+		i1, i2, i3 := mesh.vertices(i)
+		p1, p2, p3 := mesh.Positions[i1], mesh.Positions[i2], mesh.Positions[i3]
+
+		// the cross product is twice the area of the triangle long
+		cross := vec3.Cross(vec3.Sub(p2, p1), vec3.Sub(p3, p1))
+		if cross.NearZero() {
+			continue
+		}
+
+		switch weighting {
+		case AreaWeighted:
+			normals[i1] = vec3.Add(normals[i1], cross)
+			normals[i2] = vec3.Add(normals[i2], cross)
+			normals[i3] = vec3.Add(normals[i3], cross)
+		default:
+			normal := cross.Normalized()
+			normals[i1] = vec3.Add(normals[i1], vec3.MultiplyScalar(normal, cornerAngle(p1, p2, p3)))
+			normals[i2] = vec3.Add(normals[i2], vec3.MultiplyScalar(normal, cornerAngle(p2, p3, p1)))
+			normals[i3] = vec3.Add(normals[i3], vec3.MultiplyScalar(normal, cornerAngle(p3, p1, p2)))
+		}
+	}
+
+	for i, normal := range normals {
+		if !normal.NearZero() {
+			normals[i] = normal.Normalized()
+		} else {
+			normals[i] = vec3.Vec3{}
+		}
+	}
+
+	mesh.Normals = normals
+}
+
+func (mesh *TriangleMesh) Hit(r *ray.Ray, minDistance float64, maxDistance float64) HitRecord {
+	closest := HitRecord{Hit: false}
+
+	mesh.bvh.Traverse(r, minDistance, maxDistance, nil, func(leaf *BVHNode) float64 {
+		for _, index := range mesh.bvh.Indices[leaf.First : leaf.First+leaf.Count] {
+			hit := mesh.Triangle(index).Hit(r, minDistance, maxDistance)
+			if hit.Hit {
+				maxDistance = hit.Distance
+				closest = hit
+			}
+		}
+		return maxDistance
+	})
+
+	return closest
+}
+
+func (mesh *TriangleMesh) Occludes(r *ray.Ray, minDistance float64, maxDistance float64) bool {
+	return mesh.bvh.TraverseAny(r, minDistance, maxDistance, func(leaf *BVHNode) bool {
+		for _, index := range mesh.bvh.Indices[leaf.First : leaf.First+leaf.Count] {
+			if mesh.Triangle(index).Occludes(r, minDistance, maxDistance) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Accelerators index the triangles of a mesh individually.
+func (mesh *TriangleMesh) AABBIntersections(aabb AABB) []Geometry {
+	intersections := make([]Geometry, 0)
+	for i := 0; i < mesh.TriangleCount(); i++ {
+		triangle := mesh.Triangle(i)
+		if triangle.IntersectsAABB(aabb) {
+			intersections = append(intersections, triangle)
+		}
+	}
+	return intersections
+}
+
+func (mesh *TriangleMesh) IntersectsAABB(aabb AABB) bool {
+	for i := 0; i < mesh.TriangleCount(); i++ {
+		if mesh.Triangle(i).IntersectsAABB(aabb) {
+			return true
+		}
+	}
+	return false
+}
+
+func (mesh *TriangleMesh) BoundingBox() AABB {
+	if len(mesh.bvh.Nodes) == 0 {
+		return EmptyAABB()
+	}
+	return mesh.bvh.Nodes[0].Aabb
+}
+
+func (mesh *TriangleMesh) GetId() uint32 {
+	return mesh.Id
+}
+
+// A MeshTriangle is a single triangle of a TriangleMesh, referencing its vertices.
+type MeshTriangle struct {
+	Mesh  *TriangleMesh
+	Index int
+}
+
+func (triangle MeshTriangle) Positions() (vec3.Vec3, vec3.Vec3, vec3.Vec3) {
+	i1, i2, i3 := triangle.Mesh.vertices(triangle.Index)
+	positions := triangle.Mesh.Positions
+	return positions[i1], positions[i2], positions[i3]
+}
+
+func (triangle MeshTriangle) Hit(r *ray.Ray, minDistance float64, maxDistance float64) HitRecord {
+	p1, p2, p3 := triangle.Positions()
+	t, u, v, hit := intersectTriangle(r, p1, p2, p3, minDistance, maxDistance)
+	if !hit {
+		return HitRecord{Hit: false}
+	}
+
+	edge1, edge2 := edges(p1, p2, p3)
+	geometricNormal, frontFace := faceNormal(r, vec3.Cross(edge1, edge2).Normalized())
+
+	hitRecord := HitRecord{
+		Hit:             true,
+		Distance:        t,
+		Point:           r.At(t),
+		Normal:          geometricNormal,
+		GeometricNormal: geometricNormal,
+		U:               u,
+		V:               v,
+		FrontFace:       frontFace,
+	}
+
+	mesh := triangle.Mesh
+	i1, i2, i3 := mesh.vertices(triangle.Index)
+
+	var normals, tangents *[3]vec3.Vec3
+	var uvs *[3]TexCoord
+	if len(mesh.Normals) == len(mesh.Positions) {
+		normals = &[3]vec3.Vec3{mesh.Normals[i1], mesh.Normals[i2], mesh.Normals[i3]}
+	}
+	if len(mesh.UVs) == len(mesh.Positions) {
+		uvs = &[3]TexCoord{mesh.UVs[i1], mesh.UVs[i2], mesh.UVs[i3]}
+	}
+	if len(mesh.Tangents) == len(mesh.Positions) {
+		tangents = &[3]vec3.Vec3{mesh.Tangents[i1], mesh.Tangents[i2], mesh.Tangents[i3]}
+	}
+	var tangentSigns *[3]float64
+	if tangents != nil && len(mesh.TangentSigns) == len(mesh.Positions) {
+		tangentSigns = &[3]float64{mesh.TangentSigns[i1], mesh.TangentSigns[i2], mesh.TangentSigns[i3]}
+	}
+
+	shadeTriangle(&hitRecord, edge1, edge2, u, v, normals, uvs, tangents, tangentSigns)
+	return hitRecord
+}
+
+func (triangle MeshTriangle) Occludes(r *ray.Ray, minDistance float64, maxDistance float64) bool {
+	p1, p2, p3 := triangle.Positions()
+	_, _, _, hit := intersectTriangle(r, p1, p2, p3, minDistance, maxDistance)
+	return hit
+}
+
+func (triangle MeshTriangle) AABBIntersections(aabb AABB) []Geometry {
+	if triangle.IntersectsAABB(aabb) {
+		intersections := make([]Geometry, 1)
+		intersections[0] = triangle
+		return intersections
+	}
+
+	return nil
+}
+
+// uses the same separating axis test as Triangle
+func (triangle MeshTriangle) IntersectsAABB(aabb AABB) bool {
+	p1, p2, p3 := triangle.Positions()
+	return Triangle{P1: p1, P2: p2, P3: p3}.IntersectsAABB(aabb)
+}
+
+func (triangle MeshTriangle) BoundingBox() AABB {
+	p1, p2, p3 := triangle.Positions()
+	return AABB{Min: vec3.Min(p1, vec3.Min(p2, p3)), Max: vec3.Max(p1, vec3.Max(p2, p3))}
+}
+
+// GetId is the id of the mesh. A triangle is only told apart from its siblings by Index.
+func (triangle MeshTriangle) GetId() uint32 {
+	return triangle.Mesh.Id
+}
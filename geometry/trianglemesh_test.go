@@ -0,0 +1,251 @@
+package geometry
+
+import (
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// a unit cube with every face split into two triangles, wound counter clockwise seen from outside
+func cubeMesh(t *testing.T) *TriangleMesh {
+	positions := make([]vec3.Vec3, 0, 8)
+	for i := 0; i < 8; i++ {
+		positions = append(positions, vec3.Vec3{X: float64(i & 1), Y: float64(i >> 1 & 1), Z: float64(i >> 2 & 1)})
+	}
+	indices := []uint32{
+		0, 2, 3, 0, 3, 1, // z = 0
+		4, 5, 7, 4, 7, 6, // z = 1
+		0, 1, 5, 0, 5, 4, // y = 0
+		2, 6, 7, 2, 7, 3, // y = 1
+		0, 4, 6, 0, 6, 2, // x = 0
+		1, 3, 7, 1, 7, 5, // x = 1
+	}
+
+	mesh, err := NewTriangleMesh(0, positions, indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mesh
+}
+
+func TestNewTriangleMesh_ValidatesIndices(t *testing.T) {
+	positions := []vec3.Vec3{{}, {X: 1}, {Y: 1}}
+
+	if _, err := NewTriangleMesh(0, positions, []uint32{0, 1}); err == nil {
+		t.Error("accepted a partial triangle")
+	}
+	if _, err := NewTriangleMesh(0, positions, []uint32{0, 1, 3}); err == nil {
+		t.Error("accepted an index out of range")
+	}
+	if mesh, err := NewTriangleMesh(0, positions, []uint32{0, 1, 2}); err != nil || mesh.TriangleCount() != 1 {
+		t.Error(err)
+	}
+}
+
+func TestTriangleMesh_ShortAttributes(t *testing.T) {
+	mesh := cubeMesh(t)
+	if err := mesh.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// one short of the 8 vertices, so the last vertex has none
+	mesh.Normals = make([]vec3.Vec3, 7)
+	mesh.UVs = make([]TexCoord, 7)
+	mesh.Tangents = make([]vec3.Vec3, 7)
+	if err := mesh.Validate(); err == nil {
+		t.Error("accepted attributes shorter than the positions")
+	}
+
+	// the ray hits the x = 1 face next to vertex 7
+	r := ray.New(vec3.Vec3{X: 2, Y: .9, Z: .9}, vec3.Vec3{X: -1})
+	hit := mesh.Hit(r, 0, math.Inf(1))
+	if !hit.Hit || hit.Normal != (vec3.Vec3{X: 1}) {
+		t.Error(hit)
+	}
+}
+
+func TestTriangleMesh_CubeFacesPointOutwards(t *testing.T) {
+	mesh := cubeMesh(t)
+	center := vec3.Vec3{X: .5, Y: .5, Z: .5}
+
+	for i := 0; i < mesh.TriangleCount(); i++ {
+		p1, p2, p3 := mesh.Triangle(i).Positions()
+		normal := vec3.Cross(vec3.Sub(p2, p1), vec3.Sub(p3, p1))
+		if vec3.Dot(normal, vec3.Sub(p1, center)) <= 0 {
+			t.Fatal("triangle", i, "faces inwards")
+		}
+	}
+}
+
+func TestTriangleMesh_AngleWeightedNormalsIgnoreTriangulation(t *testing.T) {
+	mesh := cubeMesh(t)
+
+	// at every corner three faces meet at right angles, whether they are split there or not
+	mesh.ComputeNormals(AngleWeighted)
+	for i, normal := range mesh.Normals {
+		expected := vec3.Sub(mesh.Positions[i], vec3.Vec3{X: .5, Y: .5, Z: .5}).Normalized()
+		if !nearlyEqual(normal, expected) {
+			t.Errorf("angle weighted normal %d = %v, want %v", i, normal, expected)
+		}
+	}
+
+	// weighting by area counts a face twice where both of its triangles touch the corner
+	mesh.ComputeNormals(AreaWeighted)
+	skewed := 0
+	for i, normal := range mesh.Normals {
+		expected := vec3.Sub(mesh.Positions[i], vec3.Vec3{X: .5, Y: .5, Z: .5}).Normalized()
+		if math.Abs(normal.Length()-1) > 1e-9 {
+			t.Errorf("area weighted normal %d = %v isn't unit length", i, normal)
+		}
+		if !nearlyEqual(normal, expected) {
+			skewed++
+		}
+	}
+	if skewed == 0 {
+		t.Error("area weighting didn't depend on the triangulation")
+	}
+}
+
+func TestTriangleMesh_HitMatchesPolygon(t *testing.T) {
+	random := rand.New(rand.NewSource(0))
+	triangles := randomTriangles(200, random)
+
+	positions := make([]vec3.Vec3, 0, 3*len(triangles))
+	indices := make([]uint32, 0, 3*len(triangles))
+	for _, triangle := range triangles {
+		indices = append(indices, uint32(len(positions)), uint32(len(positions)+1), uint32(len(positions)+2))
+		positions = append(positions, triangle.P1, triangle.P2, triangle.P3)
+	}
+
+	mesh, err := NewTriangleMesh(0, positions, indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	polygon := NewPolygon(0, triangles)
+
+	for i := 0; i < 1000; i++ {
+		origin := vec3.MultiplyScalar(vec3.RandomInUnitSphere(random).Normalized(), 20)
+		r := ray.New(origin, vec3.Sub(vec3.RandomInUnitSphere(random), origin).Normalized())
+
+		got := mesh.Hit(r, 0, math.Inf(1))
+		want := polygon.Hit(r, 0, math.Inf(1))
+		if got.Hit != want.Hit || got.Distance != want.Distance || !nearlyEqual(got.GeometricNormal, want.GeometricNormal) {
+			t.Fatalf("ray %d: mesh %+v, polygon %+v", i, got, want)
+		}
+		if mesh.Occludes(r, 0, math.Inf(1)) != want.Hit {
+			t.Fatal("ray", i, "Occludes disagrees with Hit")
+		}
+	}
+}
+
+// the bitangent follows the tangent sign, on either side, rather than the UVs
+func TestTriangleMesh_TangentSignsSetHandedness(t *testing.T) {
+	mesh, err := NewTriangleMesh(0, []vec3.Vec3{{}, {X: 1}, {Y: 1}}, []uint32{0, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mesh.UVs = []TexCoord{{U: 0, V: 0}, {U: 1, V: 0}, {U: 0, V: 1}}
+	mesh.Tangents = []vec3.Vec3{{X: 1}, {X: 1}, {X: 1}}
+
+	tests := []struct {
+		name          string
+		signs         []float64
+		origin        vec3.Vec3
+		wantBitangent vec3.Vec3
+	}{
+		{name: "no signs", signs: nil, origin: vec3.Vec3{X: .25, Y: .25, Z: 1}, wantBitangent: vec3.Vec3{Y: 1}},
+		{name: "positive", signs: []float64{1, 1, 1}, origin: vec3.Vec3{X: .25, Y: .25, Z: 1}, wantBitangent: vec3.Vec3{Y: 1}},
+		{name: "negative", signs: []float64{-1, -1, -1}, origin: vec3.Vec3{X: .25, Y: .25, Z: 1}, wantBitangent: vec3.Vec3{Y: -1}},
+		{name: "negative from behind", signs: []float64{-1, -1, -1}, origin: vec3.Vec3{X: .25, Y: .25, Z: -1}, wantBitangent: vec3.Vec3{Y: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mesh.TangentSigns = tt.signs
+			hit := mesh.Hit(ray.New(tt.origin, vec3.Vec3{Z: -tt.origin.Z}), 0, math.Inf(1))
+			if !hit.Hit || !nearlyEqual(hit.Bitangent, tt.wantBitangent) {
+				t.Errorf("Bitangent = %v, want %v", hit.Bitangent, tt.wantBitangent)
+			}
+		})
+	}
+}
+
+func TestTriangleMesh_SmoothNormalsKeepGeometricNormal(t *testing.T) {
+	// a unit sphere sampled on a latitude longitude grid
+	const rings, segments = 16, 32
+	var positions []vec3.Vec3
+	for ring := 0; ring <= rings; ring++ {
+		theta := math.Pi * float64(ring) / rings
+		for segment := 0; segment <= segments; segment++ {
+			phi := 2 * math.Pi * float64(segment) / segments
+			positions = append(positions, vec3.Vec3{
+				X: math.Sin(theta) * math.Cos(phi),
+				Y: math.Cos(theta),
+				Z: math.Sin(theta) * math.Sin(phi),
+			})
+		}
+	}
+	var indices []uint32
+	for ring := 0; ring < rings; ring++ {
+		for segment := 0; segment < segments; segment++ {
+			a := uint32(ring*(segments+1) + segment)
+			b := a + segments + 1
+			indices = append(indices, a, a+1, b, a+1, b+1, b)
+		}
+	}
+
+	mesh, err := NewTriangleMesh(0, positions, indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mesh.ComputeNormals(AngleWeighted)
+
+	random := rand.New(rand.NewSource(0))
+	smoother := 0
+	for i := 0; i < 1000; i++ {
+		origin := vec3.MultiplyScalar(vec3.RandomInUnitSphere(random).Normalized(), 5)
+		hit := mesh.Hit(ray.New(origin, vec3.MultiplyScalar(origin, -1)), 0, math.Inf(1))
+		if !hit.Hit {
+			t.Fatal("missed the sphere", i)
+		}
+
+		// both normals face the ray, the shading one is closer to the true sphere normal
+		radial := hit.Point.Normalized()
+		if vec3.Dot(hit.Normal, origin) <= 0 || vec3.Dot(hit.GeometricNormal, origin) <= 0 {
+			t.Fatal(i, hit.Normal, hit.GeometricNormal)
+		}
+		if vec3.Dot(hit.Normal, radial) >= vec3.Dot(hit.GeometricNormal, radial) {
+			smoother++
+		}
+	}
+	if smoother < 900 {
+		t.Error("interpolated normals are closer to the sphere in only", smoother, "of 1000 hits")
+	}
+}
+
+func TestHitRecord_SpawnRayLeavesTheSurface(t *testing.T) {
+	mesh := cubeMesh(t)
+	mesh.ComputeNormals(AngleWeighted)
+	random := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 1000; i++ {
+		origin := vec3.Add(vec3.Vec3{X: .5, Y: .5, Z: .5}, vec3.MultiplyScalar(vec3.RandomInUnitSphere(random).Normalized(), 3))
+		hit := mesh.Hit(ray.New(origin, vec3.Sub(vec3.Vec3{X: .5, Y: .5, Z: .5}, origin)), 0, math.Inf(1))
+		if !hit.Hit {
+			t.Fatal("missed the cube", i)
+		}
+
+		// reflected rays must not hit the face they start on, transmitted ones must cross it
+		// without any minimum distance to hide self intersections
+		reflected := hit.SpawnRay(hit.GeometricNormal)
+		if mesh.Occludes(reflected, 0, 1e-3) {
+			t.Fatal(i, "reflected ray hit its own surface")
+		}
+
+		transmitted := hit.SpawnRay(vec3.MultiplyScalar(hit.GeometricNormal, -1))
+		if !mesh.Occludes(transmitted, 0, 2) || mesh.Hit(transmitted, 0, 2).FrontFace {
+			t.Fatal(i, "transmitted ray didn't reach the far side from the inside")
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package gltf
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/vec3"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type buffer struct {
+	URI        string `json:"uri"`
+	ByteLength int    `json:"byteLength"`
+}
+
+type bufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset"`
+	ByteLength int `json:"byteLength"`
+	ByteStride int `json:"byteStride"`
+}
+
+type accessor struct {
+	BufferView    *int        `json:"bufferView"`
+	ByteOffset    int         `json:"byteOffset"`
+	ComponentType int         `json:"componentType"`
+	Normalized    bool        `json:"normalized"`
+	Count         int         `json:"count"`
+	Type          string      `json:"type"`
+	Sparse        interface{} `json:"sparse"`
+}
+
+type gltfPrimitive struct {
+	Attributes map[string]int `json:"attributes"`
+	Indices    *int           `json:"indices"`
+	Material   *int           `json:"material"`
+	Mode       *int           `json:"mode"`
+}
+
+type gltfMesh struct {
+	Primitives []gltfPrimitive `json:"primitives"`
+}
+
+// a primitive of a glTF mesh, shared by every node that places the mesh
+type primitive struct {
+	mesh     *geometry.TriangleMesh
+	material material.Material
+}
+
+// primitive modes
+const (
+	modeTriangles     = 4
+	modeTriangleStrip = 5
+	modeTriangleFan   = 6
+)
+
+// component types, with the size of each
+const (
+	componentByte          = 5120
+	componentUnsignedByte  = 5121
+	componentShort         = 5122
+	componentUnsignedShort = 5123
+	componentUnsignedInt   = 5125
+	componentFloat         = 5126
+)
+
+var componentSizes = map[int]int{
+	componentByte:          1,
+	componentUnsignedByte:  1,
+	componentShort:         2,
+	componentUnsignedShort: 2,
+	componentUnsignedInt:   4,
+	componentFloat:         4,
+}
+
+// matrices are only used by skins, which aren't imported
+var componentCounts = map[string]int{
+	"SCALAR": 1,
+	"VEC2":   2,
+	"VEC3":   3,
+	"VEC4":   4,
+}
+
+// buffer returns the contents of buffer index: the GLB binary chunk, a data URI or a file
+func (l *loader) buffer(index int) ([]byte, error) {
+	if data, ok := l.buffers[index]; ok {
+		return data, nil
+	}
+	if index < 0 || index >= len(l.doc.Buffers) {
+		return nil, l.errorf("buffer %d does not exist", index)
+	}
+	b := l.doc.Buffers[index]
+
+	var data []byte
+	var err error
+	switch {
+	case b.URI == "":
+		if index != 0 || l.glbBIN == nil {
+			return nil, l.errorf("buffer %d has no uri", index)
+		}
+		data = l.glbBIN
+	default:
+		data, err = l.readURI(b.URI)
+		if err != nil {
+			return nil, l.errorf("buffer %d: %v", index, err)
+		}
+	}
+
+	if len(data) < b.ByteLength {
+		return nil, l.errorf("buffer %d is %d bytes long, expected %d", index, len(data), b.ByteLength)
+	}
+
+	l.buffers[index] = data
+	return data, nil
+}
+
+// readURI reads a base64 data URI or a file relative to the glTF file
+func (l *loader) readURI(uri string) ([]byte, error) {
+	if strings.HasPrefix(uri, "data:") {
+		comma := strings.IndexByte(uri, ',')
+		if comma < 0 || !strings.HasSuffix(uri[:comma], ";base64") {
+			return nil, fmt.Errorf("data uri is not base64 encoded")
+		}
+		return base64.StdEncoding.DecodeString(uri[comma+1:])
+	}
+
+	path, err := url.PathUnescape(uri)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(path, "://") {
+		return nil, fmt.Errorf("only relative file uris are supported, not %s", uri)
+	}
+	return os.ReadFile(filepath.Join(l.dir, filepath.FromSlash(path)))
+}
+
+// bufferViewData returns the bytes of a buffer view
+func (l *loader) bufferViewData(index int) ([]byte, bufferView, error) {
+	if index < 0 || index >= len(l.doc.BufferViews) {
+		return nil, bufferView{}, l.errorf("buffer view %d does not exist", index)
+	}
+	view := l.doc.BufferViews[index]
+
+	data, err := l.buffer(view.Buffer)
+	if err != nil {
+		return nil, view, err
+	}
+	if view.ByteOffset < 0 || view.ByteLength < 0 || view.ByteOffset+view.ByteLength > len(data) {
+		return nil, view, l.errorf("buffer view %d runs past the end of buffer %d", index, view.Buffer)
+	}
+	return data[view.ByteOffset : view.ByteOffset+view.ByteLength], view, nil
+}
+
+// limits the all zero accessors, whose count isn't backed by any data
+const maxZeroCount = 1 << 20
+
+// readAccessor returns the elements of an accessor as float64s, components per element.
+// Normalized integers are mapped to [0, 1], or [-1, 1] when signed.
+func (l *loader) readAccessor(index int) ([]float64, int, error) {
+	if index < 0 || index >= len(l.doc.Accessors) {
+		return nil, 0, l.errorf("accessor %d does not exist", index)
+	}
+	a := l.doc.Accessors[index]
+
+	components, ok := componentCounts[a.Type]
+	if !ok {
+		return nil, 0, l.errorf("accessor %d: unsupported type %q", index, a.Type)
+	}
+	size, ok := componentSizes[a.ComponentType]
+	if !ok {
+		return nil, 0, l.errorf("accessor %d: unknown component type %d", index, a.ComponentType)
+	}
+	if a.Sparse != nil {
+		return nil, 0, l.errorf("accessor %d: sparse accessors are not supported", index)
+	}
+	if a.Count < 0 {
+		return nil, 0, l.errorf("accessor %d: negative count", index)
+	}
+
+	// without a buffer view, an accessor is all zeros. Nothing bounds its count then,
+	// so it's limited rather than trusted with an allocation.
+	if a.BufferView == nil {
+		if a.Count > maxZeroCount {
+			return nil, 0, l.errorf("accessor %d: %d elements without a buffer view, at most %d are supported", index, a.Count, maxZeroCount)
+		}
+		return make([]float64, a.Count*components), components, nil
+	}
+
+	data, view, err := l.bufferViewData(*a.BufferView)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	elementSize := size * components
+	stride := view.ByteStride
+	if stride == 0 {
+		stride = elementSize
+	}
+	if stride < elementSize {
+		return nil, 0, l.errorf("accessor %d: byte stride %d is shorter than its %d byte elements", index, stride, elementSize)
+	}
+	// divides rather than multiplying the count, which could overflow
+	if a.Count > 0 && (a.ByteOffset < 0 || a.ByteOffset+elementSize > len(data) ||
+		a.Count-1 > (len(data)-a.ByteOffset-elementSize)/stride) {
+		return nil, 0, l.errorf("accessor %d runs past the end of buffer view %d", index, *a.BufferView)
+	}
+
+	values := make([]float64, a.Count*components)
+
+	for i := 0; i < a.Count; i++ {
+		element := data[a.ByteOffset+stride*i:]
+		for c := 0; c < components; c++ {
+			values[i*components+c] = readComponent(element[c*size:], a.ComponentType, a.Normalized)
+		}
+	}
+
+	return values, components, nil
+}
+
+// glTF buffers are little endian
+func readComponent(data []byte, componentType int, normalized bool) float64 {
+	switch componentType {
+	case componentByte:
+		if normalized {
+			return math.Max(float64(int8(data[0]))/math.MaxInt8, -1)
+		}
+		return float64(int8(data[0]))
+	case componentUnsignedByte:
+		if normalized {
+			return float64(data[0]) / math.MaxUint8
+		}
+		return float64(data[0])
+	case componentShort:
+		value := int16(binary.LittleEndian.Uint16(data))
+		if normalized {
+			return math.Max(float64(value)/math.MaxInt16, -1)
+		}
+		return float64(value)
+	case componentUnsignedShort:
+		value := binary.LittleEndian.Uint16(data)
+		if normalized {
+			return float64(value) / math.MaxUint16
+		}
+		return float64(value)
+	case componentUnsignedInt:
+		return float64(binary.LittleEndian.Uint32(data))
+	default:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(data)))
+	}
+}
+
+// readAttribute reads an accessor that must have components per element and count elements
+func (l *loader) readAttribute(index int, name string, components int, count int) ([]float64, error) {
+	values, got, err := l.readAccessor(index)
+	if err != nil {
+		return nil, err
+	}
+	if got != components {
+		return nil, l.errorf("%s has %d components, expected %d", name, got, components)
+	}
+	if count >= 0 && len(values) != count*components {
+		return nil, l.errorf("%s has %d elements, expected %d", name, len(values)/components, count)
+	}
+	return values, nil
+}
+
+func vectors(values []float64, components int) []vec3.Vec3 {
+	vectors := make([]vec3.Vec3, len(values)/components)
+	for i := range vectors {
+		vectors[i] = vec3.Vec3{X: values[i*components], Y: values[i*components+1], Z: values[i*components+2]}
+	}
+	return vectors
+}
+
+// triangleList turns strips and fans into a list of triangles
+func triangleList(indices []uint32, mode int) []uint32 {
+	switch mode {
+	case modeTriangleStrip:
+		var triangles []uint32
+		for i := 0; i+2 < len(indices); i++ {
+			// every other triangle is wound the other way
+			if i%2 == 0 {
+				triangles = append(triangles, indices[i], indices[i+1], indices[i+2])
+			} else {
+				triangles = append(triangles, indices[i+1], indices[i], indices[i+2])
+			}
+		}
+		return triangles
+	case modeTriangleFan:
+		var triangles []uint32
+		for i := 1; i+1 < len(indices); i++ {
+			triangles = append(triangles, indices[0], indices[i], indices[i+1])
+		}
+		return triangles
+	default:
+		return indices[:len(indices)/3*3]
+	}
+}
+
+// mesh returns the primitives of mesh index, building them the first time
+func (l *loader) mesh(index int) ([]primitive, error) {
+	if primitives, ok := l.meshes[index]; ok {
+		return primitives, nil
+	}
+	if index < 0 || index >= len(l.doc.Meshes) {
+		return nil, l.errorf("mesh %d does not exist", index)
+	}
+
+	var primitives []primitive
+	for i, p := range l.doc.Meshes[index].Primitives {
+		triangleMesh, err := l.primitive(p)
+		if err != nil {
+			return nil, fmt.Errorf("%w, in primitive %d of mesh %d", err, i, index)
+		}
+		if triangleMesh == nil {
+			continue
+		}
+
+		m, err := l.material(p.Material)
+		if err != nil {
+			return nil, err
+		}
+		primitives = append(primitives, primitive{mesh: triangleMesh, material: m})
+	}
+
+	l.meshes[index] = primitives
+	return primitives, nil
+}
+
+// primitive builds the triangle mesh of a primitive, or returns nil for points and lines
+func (l *loader) primitive(p gltfPrimitive) (*geometry.TriangleMesh, error) {
+	mode := modeTriangles
+	if p.Mode != nil {
+		mode = *p.Mode
+	}
+	if mode != modeTriangles && mode != modeTriangleStrip && mode != modeTriangleFan {
+		return nil, nil
+	}
+
+	positionAccessor, ok := p.Attributes["POSITION"]
+	if !ok {
+		return nil, l.errorf("primitive has no POSITION")
+	}
+	values, err := l.readAttribute(positionAccessor, "POSITION", 3, -1)
+	if err != nil {
+		return nil, err
+	}
+	positions := vectors(values, 3)
+
+	var indices []uint32
+	if p.Indices != nil {
+		values, err := l.readAttribute(*p.Indices, "indices", 1, -1)
+		if err != nil {
+			return nil, err
+		}
+		indices = make([]uint32, len(values))
+		for i, value := range values {
+			indices[i] = uint32(value)
+		}
+	} else {
+		indices = make([]uint32, len(positions))
+		for i := range indices {
+			indices[i] = uint32(i)
+		}
+	}
+
+	triangleMesh, err := geometry.NewTriangleMesh(l.id(), positions, triangleList(indices, mode))
+	if err != nil {
+		return nil, l.errorf("%v", err)
+	}
+
+	if normalAccessor, ok := p.Attributes["NORMAL"]; ok {
+		values, err := l.readAttribute(normalAccessor, "NORMAL", 3, len(positions))
+		if err != nil {
+			return nil, err
+		}
+		triangleMesh.Normals = vectors(values, 3)
+		for i, normal := range triangleMesh.Normals {
+			if !normal.NearZero() {
+				triangleMesh.Normals[i] = normal.Normalized()
+			}
+		}
+	}
+
+	if uvAccessor, ok := p.Attributes["TEXCOORD_0"]; ok {
+		values, err := l.readAttribute(uvAccessor, "TEXCOORD_0", 2, len(positions))
+		if err != nil {
+			return nil, err
+		}
+		triangleMesh.UVs = make([]geometry.TexCoord, len(positions))
+		for i := range triangleMesh.UVs {
+			triangleMesh.UVs[i] = geometry.TexCoord{U: values[2*i], V: 1 - values[2*i+1]}
+		}
+	}
+
+	// w is the handedness of the tangent frame
+	if tangentAccessor, ok := p.Attributes["TANGENT"]; ok {
+		values, err := l.readAttribute(tangentAccessor, "TANGENT", 4, len(positions))
+		if err != nil {
+			return nil, err
+		}
+		triangleMesh.Tangents = vectors(values, 4)
+		triangleMesh.TangentSigns = make([]float64, len(positions))
+		for i := range triangleMesh.TangentSigns {
+			triangleMesh.TangentSigns[i] = values[4*i+3]
+		}
+	}
+
+	if colorAccessor, ok := p.Attributes["COLOR_0"]; ok {
+		values, components, err := l.readAccessor(colorAccessor)
+		if err != nil {
+			return nil, err
+		}
+		if (components != 3 && components != 4) || len(values) != len(positions)*components {
+			return nil, l.errorf("COLOR_0 needs one RGB or RGBA color per vertex")
+		}
+		triangleMesh.Colors = vectors(values, components)
+	}
+
+	return triangleMesh, nil
+}
@@ -0,0 +1,472 @@
+// Package gltf imports glTF 2.0 scenes, as .gltf files with their buffers and images
+// or as binary .glb files.
+// https://registry.khronos.org/glTF/specs/2.0/glTF-2.0.html
+package gltf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"goraytracer/camera"
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/mesh"
+	"goraytracer/texture"
+	"goraytracer/transform"
+	"goraytracer/vec3"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// the parts of the glTF JSON we read, see the spec for the meaning of each field
+
+type document struct {
+	Asset struct {
+		Version string `json:"version"`
+	} `json:"asset"`
+	ExtensionsRequired []string `json:"extensionsRequired"`
+
+	Scene  *int `json:"scene"`
+	Scenes []struct {
+		Nodes []int `json:"nodes"`
+	} `json:"scenes"`
+	Nodes []node `json:"nodes"`
+
+	Meshes      []gltfMesh     `json:"meshes"`
+	Accessors   []accessor     `json:"accessors"`
+	BufferViews []bufferView   `json:"bufferViews"`
+	Buffers     []buffer       `json:"buffers"`
+	Materials   []gltfMaterial `json:"materials"`
+	Textures    []gltfTexture  `json:"textures"`
+	Images      []gltfImage    `json:"images"`
+	Samplers    []sampler      `json:"samplers"`
+	Cameras     []gltfCamera   `json:"cameras"`
+
+	Extensions struct {
+		LightsPunctual struct {
+			Lights []gltfLight `json:"lights"`
+		} `json:"KHR_lights_punctual"`
+	} `json:"extensions"`
+}
+
+type node struct {
+	Name     string `json:"name"`
+	Children []int  `json:"children"`
+	Mesh     *int   `json:"mesh"`
+	Camera   *int   `json:"camera"`
+
+	Matrix      []float64 `json:"matrix"` // column major
+	Translation []float64 `json:"translation"`
+	Rotation    []float64 `json:"rotation"` // x, y, z, w
+	Scale       []float64 `json:"scale"`
+
+	Extensions struct {
+		LightsPunctual *struct {
+			Light int `json:"light"`
+		} `json:"KHR_lights_punctual"`
+	} `json:"extensions"`
+}
+
+type gltfCamera struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Perspective *struct {
+		AspectRatio float64 `json:"aspectRatio"`
+		YFov        float64 `json:"yfov"`
+	} `json:"perspective"`
+}
+
+type gltfLight struct {
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Color     []float64 `json:"color"`
+	Intensity *float64  `json:"intensity"`
+	Range     float64   `json:"range"`
+	Spot      struct {
+		InnerConeAngle float64  `json:"innerConeAngle"`
+		OuterConeAngle *float64 `json:"outerConeAngle"`
+	} `json:"spot"`
+}
+
+// extensions whose absence wouldn't change what the scene looks like much,
+// files requiring any other one are rejected
+var supportedExtensions = map[string]bool{
+	"KHR_lights_punctual":             true,
+	"KHR_materials_emissive_strength": true,
+}
+
+// Camera is a perspective camera of the scene. glTF cameras look down their -Z axis.
+type Camera struct {
+	Name string
+
+	Eye  vec3.Vec3
+	Look vec3.Vec3
+	Up   vec3.Vec3
+
+	VerticalFov float64 // degrees
+	AspectRatio float64 // 0 when the file leaves it to the viewport
+}
+
+// Camera returns the camera, with the file's aspect ratio when it has one and aspectRatio otherwise.
+func (c Camera) Camera(aspectRatio float64) camera.Camera {
+	if c.AspectRatio > 0 {
+		aspectRatio = c.AspectRatio
+	}
+	return camera.NewWithUp(c.Eye, c.Look, c.Up, c.VerticalFov, aspectRatio)
+}
+
+type LightType string
+
+const (
+	Directional LightType = "directional"
+	Point       LightType = "point"
+	Spot        LightType = "spot"
+)
+
+// Light is a KHR_lights_punctual light placed in the world.
+type Light struct {
+	Name      string
+	Type      LightType
+	Color     vec3.Vec3
+	Intensity float64 // candela for point and spot lights, lux for directional ones
+
+	Position  vec3.Vec3
+	Direction vec3.Vec3 // the direction light travels in, for directional and spot lights
+
+	Range                          float64 // 0 is unlimited
+	InnerConeAngle, OuterConeAngle float64 // radians, spot lights only
+}
+
+// LightRadius is the radius of the spheres point and spot lights become.
+// glTF units are meters, so they are small bulbs.
+const LightRadius = .05
+
+// Scene holds what a glTF scene maps onto: meshes placed by instances, its cameras and its lights.
+//
+// The renderer only knows emissive surfaces, so point and spot lights are also added to
+// Meshes as small emissive spheres of the same intensity, ignoring spot cones.
+// Directional lights have no position to put a sphere at and are only listed.
+type Scene struct {
+	Meshes  []mesh.Mesh
+	Cameras []Camera
+	Lights  []Light
+}
+
+const (
+	glbMagic     = 0x46546C67 // "glTF"
+	glbJSONChunk = 0x4E4F534A
+	glbBINChunk  = 0x004E4942
+)
+
+// loader holds what has been read of one file
+type loader struct {
+	filename string
+	dir      string
+	doc      document
+	glbBIN   []byte
+
+	buffers   map[int][]byte
+	images    map[imageKey]*texture.Image
+	materials map[int]material.Material
+	meshes    map[int][]primitive
+
+	nextId uint32
+	scene  Scene
+}
+
+func (l *loader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("gltf: %s: %s", l.filename, fmt.Sprintf(format, args...))
+}
+
+func (l *loader) id() uint32 {
+	id := l.nextId
+	l.nextId++
+	return id
+}
+
+// Load reads a .gltf or .glb file along with the buffers and images it references,
+// and places the default scene, or the first one, in the world.
+//
+// Triangles, triangle strips and fans are imported, points and lines are skipped.
+// Materials become PBR materials, wrapped in a NormalMap when they have a normal texture.
+// Every texture is sampled with the first set of texture coordinates.
+// glTF puts V = 0 at the top of an image, so V is flipped to our convention on import.
+func Load(filename string) (*Scene, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	l := loader{
+		filename:  filename,
+		dir:       filepath.Dir(filename),
+		buffers:   make(map[int][]byte),
+		images:    make(map[imageKey]*texture.Image),
+		materials: make(map[int]material.Material),
+		meshes:    make(map[int][]primitive),
+	}
+
+	jsonData := data
+	if len(data) >= 4 && binary.LittleEndian.Uint32(data) == glbMagic {
+		if jsonData, err = l.readGLB(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(jsonData, &l.doc); err != nil {
+		return nil, l.errorf("%v", err)
+	}
+	if !strings.HasPrefix(l.doc.Asset.Version, "2.") {
+		return nil, l.errorf("unsupported glTF version %q", l.doc.Asset.Version)
+	}
+	for _, extension := range l.doc.ExtensionsRequired {
+		if !supportedExtensions[extension] {
+			return nil, l.errorf("requires unsupported extension %s", extension)
+		}
+	}
+
+	var roots []int
+	switch {
+	case l.doc.Scene != nil:
+		if *l.doc.Scene < 0 || *l.doc.Scene >= len(l.doc.Scenes) {
+			return nil, l.errorf("scene %d does not exist", *l.doc.Scene)
+		}
+		roots = l.doc.Scenes[*l.doc.Scene].Nodes
+	case len(l.doc.Scenes) > 0:
+		roots = l.doc.Scenes[0].Nodes
+	}
+
+	visiting := make([]bool, len(l.doc.Nodes))
+	for _, root := range roots {
+		if err := l.addNode(root, transform.Identity(), visiting); err != nil {
+			return nil, err
+		}
+	}
+
+	return &l.scene, nil
+}
+
+// readGLB splits a binary glTF into its JSON and keeps its binary chunk
+// https://registry.khronos.org/glTF/specs/2.0/glTF-2.0.html#binary-gltf-layout
+func (l *loader) readGLB(data []byte) ([]byte, error) {
+	if len(data) < 12 {
+		return nil, l.errorf("truncated GLB header")
+	}
+	if version := binary.LittleEndian.Uint32(data[4:]); version != 2 {
+		return nil, l.errorf("unsupported GLB version %d", version)
+	}
+	if length := binary.LittleEndian.Uint32(data[8:]); int64(length) > int64(len(data)) {
+		return nil, l.errorf("GLB is %d bytes long, the header claims %d", len(data), length)
+	} else {
+		data = data[:length]
+	}
+
+	var jsonData []byte
+	for offset := 12; offset < len(data); {
+		if offset+8 > len(data) {
+			return nil, l.errorf("truncated GLB chunk header at byte %d", offset)
+		}
+		chunkLength := int(binary.LittleEndian.Uint32(data[offset:]))
+		chunkType := binary.LittleEndian.Uint32(data[offset+4:])
+		start := offset + 8
+		if chunkLength > len(data)-start {
+			return nil, l.errorf("GLB chunk at byte %d runs past the end of the file", offset)
+		}
+		chunk := data[start : start+chunkLength]
+
+		switch {
+		case chunkType == glbJSONChunk && jsonData == nil:
+			jsonData = bytes.TrimRight(chunk, " ")
+		case chunkType == glbBINChunk && l.glbBIN == nil:
+			l.glbBIN = chunk
+		}
+
+		// chunks are padded to 4 bytes
+		offset = start + (chunkLength+3)&^3
+	}
+
+	if jsonData == nil {
+		return nil, l.errorf("GLB has no JSON chunk")
+	}
+	return jsonData, nil
+}
+
+// the local transform of a node, from its matrix or its translation, rotation and scale
+func localTransform(n *node) (transform.Matrix, error) {
+	if n.Matrix != nil {
+		if len(n.Matrix) != 16 {
+			return transform.Matrix{}, fmt.Errorf("matrix has %d elements", len(n.Matrix))
+		}
+		var m transform.Matrix
+		for column := 0; column < 4; column++ {
+			for row := 0; row < 4; row++ {
+				m[row][column] = n.Matrix[4*column+row]
+			}
+		}
+		return m, nil
+	}
+
+	translation := vec3.Vec3{}
+	if n.Translation != nil {
+		if len(n.Translation) != 3 {
+			return transform.Matrix{}, fmt.Errorf("translation has %d elements", len(n.Translation))
+		}
+		translation = vec3.Vec3{X: n.Translation[0], Y: n.Translation[1], Z: n.Translation[2]}
+	}
+
+	rotation := transform.IdentityQuaternion()
+	if n.Rotation != nil {
+		if len(n.Rotation) != 4 {
+			return transform.Matrix{}, fmt.Errorf("rotation has %d elements", len(n.Rotation))
+		}
+		rotation = transform.Quaternion{X: n.Rotation[0], Y: n.Rotation[1], Z: n.Rotation[2], W: n.Rotation[3]}.Normalized()
+	}
+
+	scale := vec3.Vec3{X: 1, Y: 1, Z: 1}
+	if n.Scale != nil {
+		if len(n.Scale) != 3 {
+			return transform.Matrix{}, fmt.Errorf("scale has %d elements", len(n.Scale))
+		}
+		scale = vec3.Vec3{X: n.Scale[0], Y: n.Scale[1], Z: n.Scale[2]}
+	}
+
+	return transform.TRS(translation, rotation, scale), nil
+}
+
+// places node index and its children, under the parent transform
+func (l *loader) addNode(index int, parent transform.Matrix, visiting []bool) error {
+	if index < 0 || index >= len(l.doc.Nodes) {
+		return l.errorf("node %d does not exist", index)
+	}
+	if visiting[index] {
+		return l.errorf("node %d is its own ancestor", index)
+	}
+	visiting[index] = true
+	defer func() { visiting[index] = false }()
+
+	n := &l.doc.Nodes[index]
+	local, err := localTransform(n)
+	if err != nil {
+		return l.errorf("node %d: %v", index, err)
+	}
+	world := transform.Multiply(parent, local)
+
+	if n.Mesh != nil {
+		primitives, err := l.mesh(*n.Mesh)
+		if err != nil {
+			return err
+		}
+		for _, p := range primitives {
+			// a zero scale hides a node
+			instance, ok := geometry.NewInstance(l.id(), p.mesh, world)
+			if ok {
+				l.scene.Meshes = append(l.scene.Meshes, mesh.Mesh{Geometry: instance, Material: p.material})
+			}
+		}
+	}
+
+	if n.Camera != nil {
+		if err := l.addCamera(*n.Camera, n, world); err != nil {
+			return err
+		}
+	}
+
+	if n.Extensions.LightsPunctual != nil {
+		if err := l.addLight(n.Extensions.LightsPunctual.Light, world); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range n.Children {
+		if err := l.addNode(child, world, visiting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *loader) addCamera(index int, n *node, world transform.Matrix) error {
+	if index < 0 || index >= len(l.doc.Cameras) {
+		return l.errorf("camera %d does not exist", index)
+	}
+	c := l.doc.Cameras[index]
+
+	// orthographic cameras have no equivalent
+	if c.Type != "perspective" || c.Perspective == nil {
+		return nil
+	}
+
+	name := c.Name
+	if name == "" {
+		name = n.Name
+	}
+
+	eye := world.TransformPoint(vec3.Vec3{})
+	l.scene.Cameras = append(l.scene.Cameras, Camera{
+		Name:        name,
+		Eye:         eye,
+		Look:        vec3.Add(eye, world.TransformVector(vec3.Vec3{Z: -1}).Normalized()),
+		Up:          world.TransformVector(vec3.Vec3{Y: 1}).Normalized(),
+		VerticalFov: c.Perspective.YFov * 180 / math.Pi,
+		AspectRatio: c.Perspective.AspectRatio,
+	})
+	return nil
+}
+
+func (l *loader) addLight(index int, world transform.Matrix) error {
+	lights := l.doc.Extensions.LightsPunctual.Lights
+	if index < 0 || index >= len(lights) {
+		return l.errorf("light %d does not exist", index)
+	}
+	gl := lights[index]
+
+	light := Light{
+		Name:      gl.Name,
+		Type:      LightType(gl.Type),
+		Color:     vec3.Vec3{X: 1, Y: 1, Z: 1},
+		Intensity: 1,
+		Position:  world.TransformPoint(vec3.Vec3{}),
+		Direction: world.TransformVector(vec3.Vec3{Z: -1}).Normalized(),
+		Range:     gl.Range,
+	}
+	if gl.Color != nil {
+		if len(gl.Color) != 3 {
+			return l.errorf("light %d: color has %d elements", index, len(gl.Color))
+		}
+		light.Color = vec3.Vec3{X: gl.Color[0], Y: gl.Color[1], Z: gl.Color[2]}
+	}
+	if gl.Intensity != nil {
+		light.Intensity = *gl.Intensity
+	}
+
+	switch light.Type {
+	case Directional:
+	case Spot:
+		light.InnerConeAngle = gl.Spot.InnerConeAngle
+		light.OuterConeAngle = math.Pi / 4
+		if gl.Spot.OuterConeAngle != nil {
+			light.OuterConeAngle = *gl.Spot.OuterConeAngle
+		}
+		fallthrough
+	case Point:
+		l.addLightSphere(light)
+	default:
+		return l.errorf("light %d: unknown type %q", index, gl.Type)
+	}
+
+	l.scene.Lights = append(l.scene.Lights, light)
+	return nil
+}
+
+// adds a sphere that emits the light's intensity: a sphere of radiance L seen from afar
+// has an intensity of L times its projected area
+func (l *loader) addLightSphere(light Light) {
+	radiance := vec3.MultiplyScalar(light.Color, light.Intensity/(math.Pi*LightRadius*LightRadius))
+	l.scene.Meshes = append(l.scene.Meshes, mesh.Mesh{
+		Geometry: geometry.Sphere{Id: l.id(), Center: light.Position, Radius: LightRadius},
+		Material: &material.Lambertian{Properties: material.MaterialProps{EmittanceColor: radiance}},
+	})
+}
@@ -0,0 +1,547 @@
+package gltf_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"goraytracer/geometry"
+	"goraytracer/gltf"
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	unsignedShort = 5123
+	float         = 5126
+)
+
+// builder assembles a glTF document and its binary buffer
+type builder struct {
+	bin       []byte
+	doc       map[string]interface{}
+	accessors []interface{}
+	views     []interface{}
+}
+
+func newBuilder() *builder {
+	return &builder{doc: map[string]interface{}{"asset": map[string]interface{}{"version": "2.0"}}}
+}
+
+// add appends values to the buffer and returns the index of an accessor for them
+func (b *builder) add(values interface{}, componentType int, accessorType string, count int) int {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, values)
+	for buf.Len()%4 != 0 {
+		buf.WriteByte(0)
+	}
+
+	b.views = append(b.views, map[string]interface{}{"buffer": 0, "byteOffset": len(b.bin), "byteLength": buf.Len()})
+	b.bin = append(b.bin, buf.Bytes()...)
+	b.accessors = append(b.accessors, map[string]interface{}{
+		"bufferView": len(b.views) - 1, "componentType": componentType, "type": accessorType, "count": count,
+	})
+	return len(b.accessors) - 1
+}
+
+func (b *builder) finish(uri string) []byte {
+	b.doc["accessors"] = b.accessors
+	b.doc["bufferViews"] = b.views
+	buffer := map[string]interface{}{"byteLength": len(b.bin)}
+	if uri != "" {
+		buffer["uri"] = uri
+	}
+	b.doc["buffers"] = []interface{}{buffer}
+
+	data, err := json.Marshal(b.doc)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// writes the document with its buffer embedded as a data URI
+func (b *builder) writeGLTF(t *testing.T) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "scene.gltf")
+	data := b.finish("data:application/octet-stream;base64," + base64.StdEncoding.EncodeToString(b.bin))
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func (b *builder) writeGLB(t *testing.T) string {
+	t.Helper()
+	jsonData := b.finish("")
+	for len(jsonData)%4 != 0 {
+		jsonData = append(jsonData, ' ')
+	}
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, []uint32{0x46546C67, 2, uint32(12 + 8 + len(jsonData) + 8 + len(b.bin))})
+	binary.Write(&buf, binary.LittleEndian, []uint32{uint32(len(jsonData)), 0x4E4F534A})
+	buf.Write(jsonData)
+	binary.Write(&buf, binary.LittleEndian, []uint32{uint32(len(b.bin)), 0x004E4942})
+	buf.Write(b.bin)
+
+	filename := filepath.Join(t.TempDir(), "scene.glb")
+	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+// a unit quad in the xy plane facing +Z, with normals, uvs and colors
+func (b *builder) addQuad() map[string]interface{} {
+	positions := b.add([]float32{0, 0, 0, 1, 0, 0, 1, 1, 0, 0, 1, 0}, float, "VEC3", 4)
+	normals := b.add([]float32{0, 0, 2, 0, 0, 2, 0, 0, 2, 0, 0, 2}, float, "VEC3", 4)
+	uvs := b.add([]float32{0, 1, 1, 1, 1, 0, 0, 0}, float, "VEC2", 4)
+	colors := b.add([]float32{1, 0, 0, 0, 1, 0, 0, 0, 1, 1, 1, 1}, float, "VEC3", 4)
+	indices := b.add([]uint16{0, 1, 2, 0, 2, 3}, unsignedShort, "SCALAR", 6)
+
+	return map[string]interface{}{
+		"attributes": map[string]interface{}{"POSITION": positions, "NORMAL": normals, "TEXCOORD_0": uvs, "COLOR_0": colors},
+		"indices":    indices,
+	}
+}
+
+func load(t *testing.T, filename string) *gltf.Scene {
+	t.Helper()
+	scene, err := gltf.Load(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return scene
+}
+
+func nearlyEqual(a vec3.Vec3, b vec3.Vec3) bool {
+	return vec3.Sub(a, b).Length() < 1e-6
+}
+
+// returns the closest hit among the meshes of the scene
+func closestHit(scene *gltf.Scene, r *ray.Ray) geometry.HitRecord {
+	closest := geometry.HitRecord{}
+	for _, m := range scene.Meshes {
+		maxDistance := math.Inf(1)
+		if closest.Hit {
+			maxDistance = closest.Distance
+		}
+		if hit := m.Geometry.Hit(r, 0, maxDistance); hit.Hit {
+			closest = hit
+		}
+	}
+	return closest
+}
+
+func hierarchy() *builder {
+	b := newBuilder()
+	b.doc["meshes"] = []interface{}{map[string]interface{}{"primitives": []interface{}{b.addQuad()}}}
+
+	// the quad twice: at z = -5, and scaled by 2 and turned to face +X at x = 3, z = -5
+	b.doc["nodes"] = []interface{}{
+		map[string]interface{}{"translation": []float64{0, 0, -5}, "children": []int{1, 2}},
+		map[string]interface{}{"mesh": 0},
+		map[string]interface{}{"mesh": 0, "matrix": []float64{
+			0, 0, -2, 0,
+			0, 2, 0, 0,
+			2, 0, 0, 0,
+			3, 0, 0, 1,
+		}},
+	}
+	b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0}}}
+	b.doc["scene"] = 0
+	return b
+}
+
+func checkHierarchy(t *testing.T, scene *gltf.Scene) {
+	t.Helper()
+
+	if len(scene.Meshes) != 2 {
+		t.Fatalf("got %d meshes, want 2", len(scene.Meshes))
+	}
+
+	hit := closestHit(scene, ray.New(vec3.Vec3{X: .5, Y: .5, Z: 10}, vec3.Vec3{Z: -1}))
+	if !hit.Hit || !nearlyEqual(hit.Point, vec3.Vec3{X: .5, Y: .5, Z: -5}) || !nearlyEqual(hit.Normal, vec3.Vec3{Z: 1}) {
+		t.Errorf("quad hit = %+v", hit)
+	}
+
+	hit = closestHit(scene, ray.New(vec3.Vec3{X: 10, Y: 1.5, Z: -6}, vec3.Vec3{X: -1}))
+	if !hit.Hit || !nearlyEqual(hit.Point, vec3.Vec3{X: 3, Y: 1.5, Z: -6}) || !nearlyEqual(hit.Normal, vec3.Vec3{X: 1}) {
+		t.Errorf("scaled quad hit = %+v", hit)
+	}
+
+	first := scene.Meshes[0].Geometry.(geometry.Instance).Geometry
+	second := scene.Meshes[1].Geometry.(geometry.Instance).Geometry
+	if first != second {
+		t.Error("the nodes don't share the mesh")
+	}
+	if scene.Meshes[0].Geometry.GetId() == scene.Meshes[1].Geometry.GetId() {
+		t.Error("the instances share an id")
+	}
+}
+
+func TestLoad_NodeHierarchy(t *testing.T) {
+	checkHierarchy(t, load(t, hierarchy().writeGLTF(t)))
+}
+
+func TestLoad_GLB(t *testing.T) {
+	checkHierarchy(t, load(t, hierarchy().writeGLB(t)))
+}
+
+func TestLoad_ExternalBuffer(t *testing.T) {
+	b := hierarchy()
+	filename := filepath.Join(t.TempDir(), "scene.gltf")
+	if err := os.WriteFile(filepath.Join(filepath.Dir(filename), "scene data.bin"), b.bin, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, b.finish("scene%20data.bin"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	checkHierarchy(t, load(t, filename))
+}
+
+func TestLoad_Attributes(t *testing.T) {
+	b := newBuilder()
+	b.doc["meshes"] = []interface{}{map[string]interface{}{"primitives": []interface{}{b.addQuad()}}}
+	b.doc["nodes"] = []interface{}{map[string]interface{}{"mesh": 0}}
+	b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0}}}
+
+	scene := load(t, b.writeGLTF(t))
+	quad := scene.Meshes[0].Geometry.(geometry.Instance).Geometry.(*geometry.TriangleMesh)
+
+	if quad.TriangleCount() != 2 || quad.Normals[0] != (vec3.Vec3{Z: 1}) || quad.Colors[1] != (vec3.Vec3{Y: 1}) {
+		t.Errorf("mesh = %+v", quad)
+	}
+	// glTF's V grows downwards, ours upwards
+	if quad.UVs[0] != (geometry.TexCoord{U: 0, V: 0}) || quad.UVs[2] != (geometry.TexCoord{U: 1, V: 1}) {
+		t.Errorf("UVs = %v", quad.UVs)
+	}
+
+	hit := closestHit(scene, ray.New(vec3.Vec3{X: .25, Y: .75, Z: 1}, vec3.Vec3{Z: -1}))
+	if math.Abs(hit.U-.25) > 1e-6 || math.Abs(hit.V-.75) > 1e-6 || !nearlyEqual(hit.Bitangent, vec3.Vec3{Y: 1}) {
+		t.Errorf("hit = %+v", hit)
+	}
+
+	if pbr, ok := scene.Meshes[0].Material.(*material.PBR); !ok || pbr.Metallic != 1 || pbr.Roughness != 1 {
+		t.Errorf("default material = %#v", scene.Meshes[0].Material)
+	}
+}
+
+func TestLoad_TangentHandedness(t *testing.T) {
+	for _, w := range []float32{1, -1} {
+		b := newBuilder()
+		quad := b.addQuad()
+		tangents := b.add([]float32{1, 0, 0, w, 1, 0, 0, w, 1, 0, 0, w, 1, 0, 0, w}, float, "VEC4", 4)
+		quad["attributes"].(map[string]interface{})["TANGENT"] = tangents
+		b.doc["meshes"] = []interface{}{map[string]interface{}{"primitives": []interface{}{quad}}}
+		b.doc["nodes"] = []interface{}{map[string]interface{}{"mesh": 0}}
+		b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0}}}
+
+		// the UVs alone would put the bitangent along +Y either way
+		hit := closestHit(load(t, b.writeGLTF(t)), ray.New(vec3.Vec3{X: .25, Y: .75, Z: 1}, vec3.Vec3{Z: -1}))
+		if !nearlyEqual(hit.Tangent, vec3.Vec3{X: 1}) || !nearlyEqual(hit.Bitangent, vec3.Vec3{Y: float64(w)}) {
+			t.Errorf("w = %v: Tangent = %v, Bitangent = %v", w, hit.Tangent, hit.Bitangent)
+		}
+	}
+}
+
+func TestLoad_PrimitiveModes(t *testing.T) {
+	b := newBuilder()
+	positions := b.add([]float32{0, 0, 0, 1, 0, 0, 0, 1, 0, 1, 1, 0, 0, 2, 0}, float, "VEC3", 5)
+	attributes := map[string]interface{}{"POSITION": positions}
+	b.doc["meshes"] = []interface{}{map[string]interface{}{"primitives": []interface{}{
+		map[string]interface{}{"attributes": attributes, "mode": 5},
+		map[string]interface{}{"attributes": attributes, "mode": 6},
+		map[string]interface{}{"attributes": attributes, "mode": 1},
+		map[string]interface{}{"attributes": attributes},
+	}}}
+	b.doc["nodes"] = []interface{}{map[string]interface{}{"mesh": 0}}
+	b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0}}}
+
+	scene := load(t, b.writeGLTF(t))
+	wantTriangles := []int{3, 3, 1}
+	if len(scene.Meshes) != len(wantTriangles) {
+		t.Fatalf("got %d meshes, want %d", len(scene.Meshes), len(wantTriangles))
+	}
+	for i, want := range wantTriangles {
+		triangles := scene.Meshes[i].Geometry.(geometry.Instance).Geometry.(*geometry.TriangleMesh)
+		if triangles.TriangleCount() != want {
+			t.Errorf("primitive %d has %d triangles, want %d", i, triangles.TriangleCount(), want)
+		}
+	}
+
+	// the strip alternates its winding, so every triangle faces +Z
+	strip := scene.Meshes[0].Geometry.(geometry.Instance).Geometry.(*geometry.TriangleMesh)
+	for i := 0; i < strip.TriangleCount(); i++ {
+		if normal := strip.Triangle(i).Hit(ray.New(vec3.Vec3{Z: 1}, vec3.Vec3{Z: -1}), 0, 10); normal.Hit && !normal.FrontFace {
+			t.Errorf("strip triangle %d faces away", i)
+		}
+	}
+}
+
+func pngDataURI(c color.NRGBA) string {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, c)
+	var buf bytes.Buffer
+	png.Encode(&buf, img)
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestLoad_Materials(t *testing.T) {
+	b := newBuilder()
+	quad := b.addQuad()
+	textured, plain, glowing := quad, map[string]interface{}{}, map[string]interface{}{}
+	for k, v := range quad {
+		plain[k] = v
+		glowing[k] = v
+	}
+	textured["material"] = 0
+	plain["material"] = 1
+	glowing["material"] = 2
+
+	b.doc["meshes"] = []interface{}{map[string]interface{}{"primitives": []interface{}{textured, plain, glowing}}}
+	b.doc["materials"] = []interface{}{
+		map[string]interface{}{
+			"pbrMetallicRoughness": map[string]interface{}{
+				"baseColorFactor":          []float64{1, .5, .25, 1},
+				"baseColorTexture":         map[string]interface{}{"index": 0},
+				"metallicRoughnessTexture": map[string]interface{}{"index": 0},
+			},
+			"normalTexture": map[string]interface{}{"index": 1, "scale": .5},
+		},
+		map[string]interface{}{
+			"pbrMetallicRoughness": map[string]interface{}{"metallicFactor": 0, "roughnessFactor": .3},
+		},
+		map[string]interface{}{
+			"emissiveFactor": []float64{1, .5, 0},
+			"extensions":     map[string]interface{}{"KHR_materials_emissive_strength": map[string]interface{}{"emissiveStrength": 4}},
+		},
+	}
+	b.doc["textures"] = []interface{}{
+		map[string]interface{}{"source": 0, "sampler": 0},
+		map[string]interface{}{"source": 1},
+	}
+	b.doc["images"] = []interface{}{
+		map[string]interface{}{"uri": pngDataURI(color.NRGBA{R: 255, G: 128, B: 0, A: 255})},
+		map[string]interface{}{"uri": pngDataURI(color.NRGBA{R: 128, G: 128, B: 255, A: 255})},
+	}
+	b.doc["samplers"] = []interface{}{map[string]interface{}{"magFilter": 9728, "wrapS": 33071, "wrapT": 33071}}
+	b.doc["nodes"] = []interface{}{map[string]interface{}{"mesh": 0}}
+	b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0}}}
+
+	scene := load(t, b.writeGLTF(t))
+	if len(scene.Meshes) != 3 {
+		t.Fatalf("got %d meshes, want 3", len(scene.Meshes))
+	}
+
+	normalMap, ok := scene.Meshes[0].Material.(*material.NormalMap)
+	if !ok || normalMap.Scale != .5 || normalMap.Map == nil {
+		t.Fatalf("textured material = %#v", scene.Meshes[0].Material)
+	}
+	texturedPBR := normalMap.Material.(*material.PBR)
+	if texturedPBR.BaseColor != (vec3.Vec3{X: 1, Y: .5, Z: .25}) || texturedPBR.BaseColorTexture == nil || texturedPBR.MetallicRoughnessTexture == nil {
+		t.Errorf("textured PBR = %+v", texturedPBR)
+	}
+
+	// the same image decoded as sRGB color and as linear data
+	srgb := texturedPBR.BaseColorTexture.Value(.5, .5, vec3.Vec3{})
+	linear := texturedPBR.MetallicRoughnessTexture.Value(.5, .5, vec3.Vec3{})
+	if math.Abs(linear.Y-128.0/255) > 1e-6 || srgb.Y >= linear.Y || srgb.X != 1 {
+		t.Errorf("base color %v, metallic roughness %v", srgb, linear)
+	}
+
+	if plainPBR := scene.Meshes[1].Material.(*material.PBR); plainPBR.Metallic != 0 || plainPBR.Roughness != .3 || plainPBR.BaseColorTexture != nil {
+		t.Errorf("plain PBR = %+v", plainPBR)
+	}
+	if emitted := scene.Meshes[2].Material.Emitted(geometry.HitRecord{}); emitted != (vec3.Vec3{X: 4, Y: 2}) {
+		t.Errorf("emitted %v", emitted)
+	}
+}
+
+func TestLoad_NormalTextureScale(t *testing.T) {
+	b := newBuilder()
+	quad := b.addQuad()
+	var primitives, materials []interface{}
+	for i, info := range []map[string]interface{}{
+		{"index": 0},
+		{"index": 0, "scale": -2},
+		{"index": 0, "scale": 0},
+	} {
+		primitive := map[string]interface{}{"material": i}
+		for k, v := range quad {
+			primitive[k] = v
+		}
+		primitives = append(primitives, primitive)
+		materials = append(materials, map[string]interface{}{"normalTexture": info})
+	}
+	b.doc["meshes"] = []interface{}{map[string]interface{}{"primitives": primitives}}
+	b.doc["materials"] = materials
+	b.doc["textures"] = []interface{}{map[string]interface{}{"source": 0}}
+	b.doc["images"] = []interface{}{map[string]interface{}{"uri": pngDataURI(color.NRGBA{R: 128, G: 128, B: 255, A: 255})}}
+	b.doc["nodes"] = []interface{}{map[string]interface{}{"mesh": 0}}
+	b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0}}}
+
+	scene := load(t, b.writeGLTF(t))
+	for i, want := range []float64{1, -2} {
+		if normalMap, ok := scene.Meshes[i].Material.(*material.NormalMap); !ok || normalMap.Scale != want {
+			t.Errorf("material %d = %#v, want a normal map scaled by %v", i, scene.Meshes[i].Material, want)
+		}
+	}
+	if _, ok := scene.Meshes[2].Material.(*material.PBR); !ok {
+		t.Errorf("material 2 = %#v, want no normal map at scale 0", scene.Meshes[2].Material)
+	}
+}
+
+func TestLoad_CamerasAndLights(t *testing.T) {
+	b := newBuilder()
+	half := math.Sqrt(.5)
+	b.doc["cameras"] = []interface{}{
+		map[string]interface{}{"type": "perspective", "perspective": map[string]interface{}{"yfov": math.Pi / 4, "aspectRatio": 2, "znear": .1}},
+		map[string]interface{}{"type": "orthographic", "orthographic": map[string]interface{}{"xmag": 1, "ymag": 1, "znear": .1, "zfar": 10}},
+	}
+	b.doc["extensions"] = map[string]interface{}{"KHR_lights_punctual": map[string]interface{}{"lights": []interface{}{
+		map[string]interface{}{"type": "point", "color": []float64{1, .5, .5}, "intensity": 10},
+		map[string]interface{}{"type": "directional"},
+	}}}
+	b.doc["nodes"] = []interface{}{
+		// turned a quarter to the left, the camera looks down -X
+		map[string]interface{}{"name": "eye", "camera": 0, "translation": []float64{1, 2, 3}, "rotation": []float64{0, half, 0, half}},
+		map[string]interface{}{"camera": 1},
+		map[string]interface{}{"translation": []float64{0, 4, 0}, "extensions": map[string]interface{}{"KHR_lights_punctual": map[string]interface{}{"light": 0}}},
+		map[string]interface{}{"rotation": []float64{-half, 0, 0, half}, "extensions": map[string]interface{}{"KHR_lights_punctual": map[string]interface{}{"light": 1}}},
+	}
+	b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{0, 1, 2, 3}}}
+	b.doc["extensionsUsed"] = []string{"KHR_lights_punctual"}
+	b.doc["extensionsRequired"] = []string{"KHR_lights_punctual"}
+
+	scene := load(t, b.writeGLTF(t))
+
+	if len(scene.Cameras) != 1 {
+		t.Fatalf("got %d cameras, want 1", len(scene.Cameras))
+	}
+	c := scene.Cameras[0]
+	if c.Name != "eye" || !nearlyEqual(c.Eye, vec3.Vec3{X: 1, Y: 2, Z: 3}) || !nearlyEqual(c.Look, vec3.Vec3{Y: 2, Z: 3}) ||
+		!nearlyEqual(c.Up, vec3.Vec3{Y: 1}) || math.Abs(c.VerticalFov-45) > 1e-9 || c.AspectRatio != 2 {
+		t.Errorf("camera = %+v", c)
+	}
+	cam := c.Camera(1)
+	if r := cam.GetRay(.5, .5); !nearlyEqual(r.Direction, vec3.Vec3{X: -1}) {
+		t.Errorf("the camera's center ray points %v", r.Direction)
+	}
+
+	if len(scene.Lights) != 2 || scene.Lights[0].Type != gltf.Point || scene.Lights[1].Type != gltf.Directional {
+		t.Fatalf("lights = %+v", scene.Lights)
+	}
+	if !nearlyEqual(scene.Lights[0].Position, vec3.Vec3{Y: 4}) || scene.Lights[0].Intensity != 10 {
+		t.Errorf("point light = %+v", scene.Lights[0])
+	}
+	if !nearlyEqual(scene.Lights[1].Direction, vec3.Vec3{Y: -1}) || scene.Lights[1].Color != (vec3.Vec3{X: 1, Y: 1, Z: 1}) {
+		t.Errorf("directional light = %+v", scene.Lights[1])
+	}
+
+	// only the point light has a sphere
+	if len(scene.Meshes) != 1 {
+		t.Fatalf("got %d meshes, want 1", len(scene.Meshes))
+	}
+	sphere := scene.Meshes[0].Geometry.(geometry.Sphere)
+	radiance := scene.Meshes[0].Material.Emitted(geometry.HitRecord{})
+	intensity := vec3.MultiplyScalar(radiance, math.Pi*sphere.Radius*sphere.Radius)
+	if sphere.Center != (vec3.Vec3{Y: 4}) || !nearlyEqual(intensity, vec3.Vec3{X: 10, Y: 5, Z: 5}) {
+		t.Errorf("light sphere at %v with intensity %v", sphere.Center, intensity)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(b *builder)
+		wantErr string
+	}{
+		{
+			name:    "version",
+			edit:    func(b *builder) { b.doc["asset"] = map[string]interface{}{"version": "1.0"} },
+			wantErr: `unsupported glTF version "1.0"`,
+		},
+		{
+			name:    "required extension",
+			edit:    func(b *builder) { b.doc["extensionsRequired"] = []string{"KHR_draco_mesh_compression"} },
+			wantErr: "requires unsupported extension KHR_draco_mesh_compression",
+		},
+		{
+			name: "accessor out of range",
+			edit: func(b *builder) {
+				b.accessors[0].(map[string]interface{})["count"] = 5
+			},
+			wantErr: "accessor 0 runs past the end of buffer view 0, in primitive 0 of mesh 0",
+		},
+		{
+			name: "huge accessor",
+			edit: func(b *builder) {
+				b.accessors[0].(map[string]interface{})["count"] = 1 << 60
+			},
+			wantErr: "accessor 0 runs past the end of buffer view 0",
+		},
+		{
+			name: "huge accessor without a buffer view",
+			edit: func(b *builder) {
+				accessor := b.accessors[0].(map[string]interface{})
+				delete(accessor, "bufferView")
+				accessor["count"] = 1 << 60
+			},
+			wantErr: "accessor 0: 1152921504606846976 elements without a buffer view",
+		},
+		{
+			name: "wrong attribute type",
+			edit: func(b *builder) {
+				b.accessors[0].(map[string]interface{})["type"] = "VEC2"
+			},
+			wantErr: "POSITION has 2 components, expected 3",
+		},
+		{
+			name: "cycle",
+			edit: func(b *builder) {
+				b.doc["nodes"] = []interface{}{map[string]interface{}{"children": []int{1}}, map[string]interface{}{"children": []int{0}}}
+			},
+			wantErr: "node 0 is its own ancestor",
+		},
+		{
+			name:    "missing node",
+			edit:    func(b *builder) { b.doc["scenes"] = []interface{}{map[string]interface{}{"nodes": []int{7}}} },
+			wantErr: "node 7 does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := hierarchy()
+			tt.edit(b)
+
+			_, err := gltf.Load(b.writeGLTF(t))
+			if err == nil || !strings.HasPrefix(err.Error(), "gltf: ") || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad_MissingBuffer(t *testing.T) {
+	b := hierarchy()
+	filename := filepath.Join(t.TempDir(), "scene.gltf")
+	if err := os.WriteFile(filename, b.finish("missing.bin"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := gltf.Load(filename); err == nil || !strings.Contains(err.Error(), "buffer 0") {
+		t.Errorf("Load() error = %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+package gltf
+
+import (
+	"bytes"
+	"goraytracer/material"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"image"
+)
+
+type textureInfo struct {
+	Index    int      `json:"index"`
+	TexCoord int      `json:"texCoord"`
+	Scale    *float64 `json:"scale"` // normal textures only, 1 when absent
+}
+
+type gltfMaterial struct {
+	Name                 string `json:"name"`
+	PBRMetallicRoughness struct {
+		BaseColorFactor          []float64    `json:"baseColorFactor"`
+		BaseColorTexture         *textureInfo `json:"baseColorTexture"`
+		MetallicFactor           *float64     `json:"metallicFactor"`
+		RoughnessFactor          *float64     `json:"roughnessFactor"`
+		MetallicRoughnessTexture *textureInfo `json:"metallicRoughnessTexture"`
+	} `json:"pbrMetallicRoughness"`
+	NormalTexture   *textureInfo `json:"normalTexture"`
+	EmissiveTexture *textureInfo `json:"emissiveTexture"`
+	EmissiveFactor  []float64    `json:"emissiveFactor"`
+
+	Extensions struct {
+		EmissiveStrength *struct {
+			EmissiveStrength float64 `json:"emissiveStrength"`
+		} `json:"KHR_materials_emissive_strength"`
+	} `json:"extensions"`
+}
+
+type gltfTexture struct {
+	Sampler *int `json:"sampler"`
+	Source  *int `json:"source"`
+}
+
+type gltfImage struct {
+	URI        string `json:"uri"`
+	BufferView *int   `json:"bufferView"`
+}
+
+type sampler struct {
+	MagFilter int `json:"magFilter"`
+	WrapS     int `json:"wrapS"`
+	WrapT     int `json:"wrapT"`
+}
+
+// sampler values, mirrored repeat falls back to repeat
+const (
+	filterNearest = 9728
+	wrapClamp     = 33071
+)
+
+// textures are decoded once per color space they are used in
+type imageKey struct {
+	texture    int
+	colorSpace texture.ColorSpace
+}
+
+// defaultMaterial is what primitives without a material get, as the spec describes it
+func defaultMaterial() material.Material {
+	return &material.PBR{BaseColor: vec3.Vec3{X: 1, Y: 1, Z: 1}, Metallic: 1, Roughness: 1}
+}
+
+func color(values []float64, fallback vec3.Vec3) vec3.Vec3 {
+	if len(values) < 3 {
+		return fallback
+	}
+	return vec3.Vec3{X: values[0], Y: values[1], Z: values[2]}
+}
+
+// material returns material index as a PBR material, building it the first time
+func (l *loader) material(index *int) (material.Material, error) {
+	if index == nil {
+		return defaultMaterial(), nil
+	}
+	if m, ok := l.materials[*index]; ok {
+		return m, nil
+	}
+	if *index < 0 || *index >= len(l.doc.Materials) {
+		return nil, l.errorf("material %d does not exist", *index)
+	}
+	gm := l.doc.Materials[*index]
+	pbr := gm.PBRMetallicRoughness
+
+	// base color alpha is dropped, there is no transparency
+	m := &material.PBR{
+		BaseColor: color(pbr.BaseColorFactor, vec3.Vec3{X: 1, Y: 1, Z: 1}),
+		Metallic:  1,
+		Roughness: 1,
+		Emissive:  color(gm.EmissiveFactor, vec3.Vec3{}),
+	}
+	if pbr.MetallicFactor != nil {
+		m.Metallic = *pbr.MetallicFactor
+	}
+	if pbr.RoughnessFactor != nil {
+		m.Roughness = *pbr.RoughnessFactor
+	}
+	if gm.Extensions.EmissiveStrength != nil {
+		m.Emissive = vec3.MultiplyScalar(m.Emissive, gm.Extensions.EmissiveStrength.EmissiveStrength)
+	}
+
+	var err error
+	if m.BaseColorTexture, err = l.texture(pbr.BaseColorTexture, texture.SRGB); err != nil {
+		return nil, err
+	}
+	if m.MetallicRoughnessTexture, err = l.texture(pbr.MetallicRoughnessTexture, texture.Linear); err != nil {
+		return nil, err
+	}
+	if m.EmissiveTexture, err = l.texture(gm.EmissiveTexture, texture.SRGB); err != nil {
+		return nil, err
+	}
+
+	// a scale of 0 flattens the normal map away, so the material is left unmapped
+	var result material.Material = m
+	if gm.NormalTexture != nil && (gm.NormalTexture.Scale == nil || *gm.NormalTexture.Scale != 0) {
+		normalMap, err := l.texture(gm.NormalTexture, texture.Linear)
+		if err != nil {
+			return nil, err
+		}
+		scale := 1.
+		if gm.NormalTexture.Scale != nil {
+			scale = *gm.NormalTexture.Scale
+		}
+		result = &material.NormalMap{Material: m, Map: normalMap, Scale: scale}
+	}
+
+	l.materials[*index] = result
+	return result, nil
+}
+
+// texture decodes the image of a texture reference. Without one it returns a nil interface,
+// not a nil *Image, which materials would sample.
+func (l *loader) texture(info *textureInfo, colorSpace texture.ColorSpace) (texture.Texture, error) {
+	if info == nil {
+		return nil, nil
+	}
+
+	key := imageKey{texture: info.Index, colorSpace: colorSpace}
+	if img, ok := l.images[key]; ok {
+		return img, nil
+	}
+
+	if info.Index < 0 || info.Index >= len(l.doc.Textures) {
+		return nil, l.errorf("texture %d does not exist", info.Index)
+	}
+	t := l.doc.Textures[info.Index]
+	if t.Source == nil {
+		return nil, l.errorf("texture %d has no image", info.Index)
+	}
+	if *t.Source < 0 || *t.Source >= len(l.doc.Images) {
+		return nil, l.errorf("image %d does not exist", *t.Source)
+	}
+	gi := l.doc.Images[*t.Source]
+
+	var data []byte
+	var err error
+	if gi.BufferView != nil {
+		data, _, err = l.bufferViewData(*gi.BufferView)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		data, err = l.readURI(gi.URI)
+		if err != nil {
+			return nil, l.errorf("image %d: %v", *t.Source, err)
+		}
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, l.errorf("image %d: %v", *t.Source, err)
+	}
+	img := texture.NewImage(decoded, colorSpace)
+
+	if t.Sampler != nil {
+		if *t.Sampler < 0 || *t.Sampler >= len(l.doc.Samplers) {
+			return nil, l.errorf("sampler %d does not exist", *t.Sampler)
+		}
+		s := l.doc.Samplers[*t.Sampler]
+		if s.WrapS == wrapClamp && s.WrapT == wrapClamp {
+			img.Wrap = texture.Clamp
+		}
+		if s.MagFilter == filterNearest {
+			img.Filter = texture.Nearest
+		}
+	}
+
+	l.images[key] = img
+	return img, nil
+}
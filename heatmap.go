@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"goraytracer/accel"
+	"goraytracer/camera"
+	"goraytracer/mathutils"
+	"goraytracer/ppm"
+	"goraytracer/vec3"
+	"math"
+	"path/filepath"
+	"strings"
+)
+
+// colours from no work to the most work, evenly spaced
+var heatmapStops = []vec3.Vec3{
+	{X: 0, Y: 0, Z: 0},
+	{X: 0, Y: 0, Z: 1},
+	{X: 0, Y: 1, Z: 1},
+	{X: 0, Y: 1, Z: 0},
+	{X: 1, Y: 1, Z: 0},
+	{X: 1, Y: 0, Z: 0},
+	{X: 1, Y: 1, Z: 1},
+}
+
+// heatColor maps t in [0, 1] onto the heatmap gradient
+func heatColor(t float64) vec3.Vec3 {
+	t = math.Max(0, math.Min(1, t)) * float64(len(heatmapStops)-1)
+	i := int(t)
+	if i >= len(heatmapStops)-1 {
+		return heatmapStops[len(heatmapStops)-1]
+	}
+	return vec3.Lerp(heatmapStops[i], heatmapStops[i+1], t-float64(i))
+}
+
+func writeHeatmap(filename string, imageWidth int, imageHeight int, counts []int) {
+	max := 1
+	for _, count := range counts {
+		if count > max {
+			max = count
+		}
+	}
+
+	pixels := make([]ppm.Pixel, len(counts))
+	for i, count := range counts {
+		color := heatColor(float64(count) / float64(max))
+		pixels[i] = ppm.Pixel{
+			R: int(mathutils.Lerp(0, 255, color.X)),
+			G: int(mathutils.Lerp(0, 255, color.Y)),
+			B: int(mathutils.Lerp(0, 255, color.Z)),
+		}
+	}
+
+	ppm.Write(filename, ppm.Build(imageWidth, imageHeight, pixels))
+	fmt.Printf("wrote %s, max %d\n", filename, max)
+}
+
+// heatmapName names a heatmap after the image it stands in for: image.ppm gets image_nodes.ppm
+func heatmapName(output string, kind string) string {
+	return strings.TrimSuffix(output, filepath.Ext(output)) + "_" + kind + ".ppm"
+}
+
+// renderHeatmaps traces one camera ray through the center of every pixel and writes images
+// coloured by how many nodes the accelerator visited and how many primitives it tested,
+// named after output.
+func renderHeatmaps(scene accel.Accelerator, cam camera.Camera, imageWidth int, imageHeight int, output string) {
+	nodes := make([]int, imageWidth*imageHeight)
+	tests := make([]int, imageWidth*imageHeight)
+
+	for j := 0; j < imageHeight; j++ {
+		for i := 0; i < imageWidth; i++ {
+			u := (float64(i) + .5) / (float64(imageWidth) - 1)
+			v := (float64(j) + .5) / (float64(imageHeight) - 1)
+			stats := scene.TraversalCost(cam.GetRay(u, v), .001, math.Inf(1))
+
+			index := (imageHeight-1-j)*imageWidth + i
+			nodes[index] = stats.NodesVisited
+			tests[index] = stats.PrimitivesTested
+		}
+	}
+
+	writeHeatmap(heatmapName(output, "nodes"), imageWidth, imageHeight, nodes)
+	writeHeatmap(heatmapName(output, "tests"), imageWidth, imageHeight, tests)
+}
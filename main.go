@@ -11,6 +11,7 @@ import (
 	"goraytracer/mesh"
 	"goraytracer/ppm"
 	"goraytracer/ray"
+	"goraytracer/scenefile"
 	"goraytracer/vec3"
 	"log"
 	"math"
@@ -21,42 +22,22 @@ import (
 	"time"
 )
 
-func findClosestMeshHit(candidates []accel.IntersectCandidate, ray *ray.Ray) (geometry.HitRecord, material.Material) {
-	minDistance := .001
-	maxDistance := math.Inf(1)
-
-	closetHit := geometry.HitRecord{Hit: false}
-	var material material.Material
-
-	for _, candidate := range candidates {
-		hitRecord := candidate.Geometry.Hit(ray, minDistance, maxDistance)
-		if hitRecord.Hit {
-			maxDistance = hitRecord.Distance
-			closetHit = hitRecord
-			material = candidate.Material
-		}
-
-	}
-
-	return closetHit, material
-}
-
-func rayColor(tree *accel.OctTree, ray *ray.Ray, depth int, random *rand.Rand) vec3.Vec3 {
+func rayColor(scene accel.Accelerator, ray *ray.Ray, depth int, random *rand.Rand) vec3.Vec3 {
 	if depth <= 0 {
 		return vec3.Vec3{}
 	}
 
-	candidates := tree.Search(ray)
-	hitRecord, material := findClosestMeshHit(candidates, ray)
+	hitRecord, material := scene.ClosestHit(ray, .001, math.Inf(1))
 
-	// scatter and recurse if there's a hit record
+	// add emitted light, then scatter and recurse if there's a hit record
 	if hitRecord.Hit {
-		attenuation, scatteredRay := material.Scatter(hitRecord, random)
+		emitted := material.Emitted(hitRecord)
+		attenuation, scatteredRay := material.Scatter(ray, hitRecord, random)
 
 		if scatteredRay != nil {
-			return vec3.Multiply(attenuation, rayColor(tree, scatteredRay, depth-1, random))
+			return vec3.Add(emitted, vec3.Multiply(attenuation, rayColor(scene, scatteredRay, depth-1, random)))
 		} else {
-			return attenuation
+			return emitted
 		}
 	}
 
@@ -70,22 +51,45 @@ func rayColor(tree *accel.OctTree, ray *ray.Ray, depth int, random *rand.Rand) v
 	//)
 }
 
-func samplePixel(i int, j int, imageWidth int, imageHeight int, camera camera.Camera, tree *accel.OctTree) vec3.Vec3 {
+func buildAccelerator(name string, meshes []mesh.Mesh) (accel.Accelerator, error) {
+	switch name {
+	case "bvh":
+		bvh := accel.BuildBVH(meshes)
+		return &bvh, nil
+	case "octree":
+		tree := accel.BuildOctTree(meshes)
+		return &tree, nil
+	default:
+		return nil, fmt.Errorf("unknown acceleration structure %q", name)
+	}
+}
+
+func acceleratorName(scene accel.Accelerator) string {
+	switch scene.(type) {
+	case *accel.BVH:
+		return "bvh"
+	case *accel.OctTree:
+		return "octree"
+	default:
+		return fmt.Sprintf("%T", scene)
+	}
+}
+
+func samplePixel(i int, j int, settings scenefile.Settings, camera camera.Camera, scene accel.Accelerator) vec3.Vec3 {
 	r := rand.New(rand.NewSource(time.Now().UnixMicro()))
-	const samplesPerPixel = 50
-	const maxDepth = 10
 	pixelColor := vec3.Vec3{}
 
-	for sample := 0; sample < samplesPerPixel; sample++ {
-		u := (float64(i) + r.Float64()) / (float64(imageWidth) - 1)
-		v := (float64(j) + r.Float64()) / (float64(imageHeight) - 1)
+	for sample := 0; sample < settings.Samples; sample++ {
+		u := (float64(i) + r.Float64()) / (float64(settings.Width) - 1)
+		v := (float64(j) + r.Float64()) / (float64(settings.Height) - 1)
 		ray := camera.GetRay(u, v)
-		pixelColor = vec3.Add(pixelColor, rayColor(tree, ray, maxDepth, r))
+		pixelColor = vec3.Add(pixelColor, rayColor(scene, ray, settings.MaxDepth, r))
 	}
 
-	// average and gamma correct
-	scale := 1.0 / float64(samplesPerPixel)
+	// average, clamp bright light to white and gamma correct
+	scale := 1.0 / float64(settings.Samples)
 	pixelColor = vec3.MultiplyScalar(pixelColor, scale)
+	pixelColor = vec3.Min(pixelColor, vec3.Vec3{X: 1, Y: 1, Z: 1})
 	pixelColor.X = math.Sqrt(pixelColor.X)
 	pixelColor.Y = math.Sqrt(pixelColor.Y)
 	pixelColor.Z = math.Sqrt(pixelColor.Z)
@@ -93,24 +97,10 @@ func samplePixel(i int, j int, imageWidth int, imageHeight int, camera camera.Ca
 	return pixelColor
 }
 
-func main() {
+// demoScene is what renders without a scene file
+func demoScene() *scenefile.Scene {
+	settings := scenefile.Settings{Width: 320, Height: 240, Samples: 50, MaxDepth: 10, Accelerator: "bvh", Output: "image.ppm"}
 
-	cpuprofile := flag.String("cpuprofile", "", "write cpu profile to file")
-	flag.Parse()
-	if *cpuprofile != "" {
-		f, err := os.Create(*cpuprofile)
-		if err != nil {
-			log.Fatal(err)
-		}
-		pprof.StartCPUProfile(f)
-		defer pprof.StopCPUProfile()
-	}
-
-	const aspectRatio = 4.0 / 3.0
-	const imageWidth = 320
-	const imageHeight = int(float64(imageWidth) / aspectRatio)
-
-	// define our scene
 	meshes := []mesh.Mesh{
 		{
 			Geometry: geometry.Sphere{
@@ -119,33 +109,143 @@ func main() {
 				Radius: 50,
 			},
 			Material: &material.Lambertian{Properties: material.MaterialProps{
-				Albedo:           vec3.Vec3{1, 0, 0},
+				Albedo:           vec3.Vec3{X: 1, Y: 0, Z: 0},
 				BaseColorTexture: nil,
-				EmittanceColor:   vec3.Vec3{1, 1, 1},
+				EmittanceColor:   vec3.Vec3{X: 1, Y: 1, Z: 1},
 			}},
 		},
 		{
 			Geometry: geometry.Sphere{
 				Id:     1,
-				Center: vec3.Vec3{20, 0, -5},
+				Center: vec3.Vec3{X: 20, Y: 0, Z: -5},
 				Radius: 2,
 			},
 			Material: &material.Lambertian{Properties: material.MaterialProps{
-				Albedo:           vec3.Vec3{.5, .5, .5},
+				Albedo:           vec3.Vec3{X: .5, Y: .5, Z: .5},
 				BaseColorTexture: nil,
-				EmittanceColor:   vec3.Vec3{0, 0, 0},
+				EmittanceColor:   vec3.Vec3{X: 0, Y: 0, Z: 0},
 			}},
 		},
 	}
 
-	startTime := time.Now().UnixMicro()
-	tree := accel.BuildOctTree(meshes)
-	endTime := time.Now().UnixMicro()
-	fmt.Printf("OctTree built in %f seconds\n", float64(endTime-startTime)/1e6)
+	return &scenefile.Scene{
+		Settings: settings,
+		Camera:   camera.New(vec3.Vec3{X: 0, Y: 0, Z: 100}, vec3.Vec3{}, 45, settings.AspectRatio()),
+		Meshes:   meshes,
+	}
+}
+
+// startProfile writes a cpu profile to filename until the returned function is called
+func startProfile(filename string) func() {
+	if filename == "" {
+		return func() {}
+	}
 
-	frameBuffer := make([]ppm.Pixel, imageWidth*imageHeight)
+	f, err := os.Create(filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pprof.StartCPUProfile(f)
+	return pprof.StopCPUProfile
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		renderCommand(os.Args[2:])
+		return
+	}
 
-	cam := camera.New(vec3.Vec3{X: 0, Y: 0, Z: 100}, vec3.Vec3{}, 45, aspectRatio)
+	cpuprofile := flag.String("cpuprofile", "", "write cpu profile to file")
+	accelerator := flag.String("accel", "bvh", "acceleration structure to use: bvh or octree")
+	cacheFile := flag.String("cache", "", "load the acceleration structure from file, building and saving it there if missing or stale")
+	heatmap := flag.Bool("heatmap", false, "write heatmaps of accelerator node visits and primitive tests, named after the output, instead of rendering")
+	flag.Parse()
+	defer startProfile(*cpuprofile)()
+
+	description := demoScene()
+	description.Settings.Accelerator = *accelerator
+	render(description, *cacheFile, *heatmap)
+}
+
+// renderCommand renders a scene file: render [flags] scene.json
+func renderCommand(args []string) {
+	flags := flag.NewFlagSet("render", flag.ExitOnError)
+	cpuprofile := flags.String("cpuprofile", "", "write cpu profile to file")
+	accelerator := flags.String("accel", "", "acceleration structure to use instead of the scene's: bvh or octree")
+	output := flags.String("o", "", "write the image here instead of the scene's output")
+	cacheFile := flags.String("cache", "", "load the acceleration structure from file, building and saving it there if missing or stale")
+	heatmap := flags.Bool("heatmap", false, "write heatmaps of accelerator node visits and primitive tests, named after the output, instead of rendering")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: %s render [flags] scene.json\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	defer startProfile(*cpuprofile)()
+
+	description, err := scenefile.Load(flags.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *accelerator != "" {
+		description.Settings.Accelerator = *accelerator
+	}
+	if *output != "" {
+		description.Settings.Output = *output
+	}
+	render(description, *cacheFile, *heatmap)
+}
+
+// render builds or loads the scene's accelerator and writes its image, or its heatmaps
+func render(description *scenefile.Scene, cacheFile string, heatmap bool) {
+	settings := description.Settings
+	meshes := description.Meshes
+
+	var scene accel.Accelerator
+
+	if cacheFile != "" {
+		cached, err := accel.LoadCache(cacheFile, meshes)
+		if err != nil {
+			fmt.Printf("not using cache %s: %v\n", cacheFile, err)
+		} else if acceleratorName(cached) != settings.Accelerator {
+			fmt.Printf("not using cache %s: it holds a %s\n", cacheFile, acceleratorName(cached))
+		} else {
+			fmt.Printf("%s loaded from %s\n", settings.Accelerator, cacheFile)
+			scene = cached
+		}
+	}
+
+	if scene == nil {
+		startTime := time.Now().UnixMicro()
+		built, err := buildAccelerator(settings.Accelerator, meshes)
+		if err != nil {
+			log.Fatal(err)
+		}
+		endTime := time.Now().UnixMicro()
+		fmt.Printf("%s built in %f seconds\n", settings.Accelerator, float64(endTime-startTime)/1e6)
+
+		if cacheFile != "" {
+			if err := accel.SaveCache(cacheFile, built, meshes); err != nil {
+				fmt.Printf("could not write cache %s: %v\n", cacheFile, err)
+			}
+		}
+		scene = built
+	}
+
+	fmt.Printf("%s: %v\n", settings.Accelerator, scene.Stats())
+
+	cam := description.Camera
+	imageWidth, imageHeight := settings.Width, settings.Height
+
+	if heatmap {
+		renderHeatmaps(scene, cam, imageWidth, imageHeight, settings.Output)
+		return
+	}
+
+	frameBuffer := make([]ppm.Pixel, imageWidth*imageHeight)
 
 	split := 8
 	wg := sync.WaitGroup{}
@@ -154,11 +254,12 @@ func main() {
 		for yRegion := 0; yRegion < split; yRegion++ {
 			wg.Add(1)
 
-			iMin := imageWidth / split * xRegion    // 0 - 560
-			iMax := iMin + (imageWidth / split) - 1 // 79 - 639
+			// regions cover the image even when its size isn't a multiple of split
+			iMin := imageWidth * xRegion / split
+			iMax := imageWidth*(xRegion+1)/split - 1
 
-			jMin := imageHeight / split * yRegion
-			jMax := jMin + (imageHeight / split) - 1
+			jMin := imageHeight * yRegion / split
+			jMax := imageHeight*(yRegion+1)/split - 1
 
 			go func(iMin int, iMax int, jMin int, jMax int) {
 				defer func() {
@@ -169,7 +270,7 @@ func main() {
 				for i := iMin; i <= iMax; i++ {
 					for j := jMin; j <= jMax; j++ {
 
-						color := samplePixel(i, j, imageWidth, imageHeight, cam, &tree)
+						color := samplePixel(i, j, settings, cam, scene)
 
 						index := (imageHeight-1-j)*imageWidth + i
 
@@ -190,5 +291,5 @@ func main() {
 	wg.Wait()
 	// done
 
-	ppm.Write("image.ppm", ppm.Build(imageWidth, imageHeight, frameBuffer))
+	ppm.Write(settings.Output, ppm.Build(imageWidth, imageHeight, frameBuffer))
 }
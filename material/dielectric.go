@@ -0,0 +1,58 @@
+package material
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+)
+
+// Dielectric is a clear material like glass (1.5) or water (1.33) that reflects
+// or refracts every ray. The surrounding medium is assumed to be air.
+type Dielectric struct {
+	RefractiveIndex float64
+}
+
+// Schlick's approximation of the Fresnel reflectance.
+// https://en.wikipedia.org/wiki/Schlick%27s_approximation
+func schlick(cosine float64, etaRatio float64) float64 {
+	r0 := (1 - etaRatio) / (1 + etaRatio)
+	r0 = r0 * r0
+	return r0 + (1-r0)*math.Pow(1-cosine, 5)
+}
+
+func (material *Dielectric) Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay) {
+	etaRatio := material.RefractiveIndex
+	if hitRecord.FrontFace {
+		etaRatio = 1.0 / material.RefractiveIndex
+	}
+
+	direction := rayIn.Direction.Normalized()
+	cosTheta := math.Min(-vec3.Dot(direction, hitRecord.Normal), 1.0)
+	sinTheta := math.Sqrt(1.0 - cosTheta*cosTheta)
+
+	// no refracted ray exists past the critical angle
+	totalInternalReflection := etaRatio*sinTheta > 1.0
+
+	var scattered vec3.Vec3
+	if totalInternalReflection || schlick(cosTheta, etaRatio) > random.Float64() {
+		scattered = vec3.Reflect(direction, hitRecord.Normal)
+	} else {
+		scattered = vec3.Refract(direction, hitRecord.Normal, etaRatio)
+	}
+
+	return vec3.Vec3{X: 1, Y: 1, Z: 1}, hitRecord.SpawnRay(scattered)
+}
+
+func (material *Dielectric) Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3 {
+	return vec3.Vec3{}
+}
+
+func (material *Dielectric) Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64 {
+	return 0
+}
+
+func (material *Dielectric) Emitted(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return vec3.Vec3{}
+}
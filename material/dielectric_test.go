@@ -0,0 +1,108 @@
+package material_test
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// hits the floor y = 0 from above at angle degrees off the normal
+func angledHit(angle float64, frontFace bool) (*ray.Ray, geometry.HitRecord) {
+	radians := angle * math.Pi / 180
+	direction := vec3.Vec3{X: math.Sin(radians), Y: -math.Cos(radians)}
+	hit := geometry.HitRecord{Hit: true, Normal: vec3.Vec3{Y: 1}, FrontFace: frontFace}
+	return ray.New(vec3.Sub(hit.Point, direction), direction), hit
+}
+
+func TestDielectric_RefractionFollowsSnellsLaw(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	glass := material.Dielectric{RefractiveIndex: 1.5}
+
+	for angle := 0.0; angle < 90; angle += 5 {
+		incoming, hit := angledHit(angle, true)
+
+		refracted := 0
+		for i := 0; i < 100; i++ {
+			attenuation, scattered := glass.Scatter(incoming, hit, r)
+			if scattered == nil || attenuation != (vec3.Vec3{X: 1, Y: 1, Z: 1}) {
+				t.Fatal(angle, "glass absorbed or tinted the ray")
+			}
+
+			direction := scattered.Direction.Normalized()
+			if direction.Y > 0 {
+				continue // reflected
+			}
+
+			refracted++
+			sinIn := math.Sin(angle * math.Pi / 180)
+			sinOut := math.Sqrt(direction.X*direction.X + direction.Z*direction.Z)
+			if math.Abs(sinIn-1.5*sinOut) > .0000001 {
+				t.Fatal(angle, sinIn, sinOut)
+			}
+		}
+
+		if refracted == 0 {
+			t.Error(angle, "never refracted entering glass")
+		}
+	}
+}
+
+func TestDielectric_NormalIncidencePassesStraightThrough(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	glass := material.Dielectric{RefractiveIndex: 1.5}
+	incoming, hit := angledHit(0, true)
+
+	for i := 0; i < 100; i++ {
+		_, scattered := glass.Scatter(incoming, hit, r)
+		if scattered.Direction.Y < 0 && !nearVec3(scattered.Direction, incoming.Direction) {
+			t.Fatal(scattered.Direction)
+		}
+	}
+}
+
+func TestDielectric_TotalInternalReflection(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	glass := material.Dielectric{RefractiveIndex: 1.5}
+
+	// leaving glass, the critical angle is asin(1/1.5) ~ 41.8 degrees
+	for _, angle := range []float64{42, 60, 89} {
+		incoming, hit := angledHit(angle, false)
+
+		for i := 0; i < 100; i++ {
+			_, scattered := glass.Scatter(incoming, hit, r)
+			expected := incoming.Direction
+			expected.Y = -expected.Y
+			if !nearVec3(scattered.Direction, expected) {
+				t.Fatal(angle, scattered.Direction)
+			}
+		}
+	}
+}
+
+func TestDielectric_ReflectanceGrowsTowardsGrazing(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	glass := material.Dielectric{RefractiveIndex: 1.5}
+
+	reflectance := func(angle float64) float64 {
+		incoming, hit := angledHit(angle, true)
+		reflected := 0
+		for i := 0; i < 10000; i++ {
+			if _, scattered := glass.Scatter(incoming, hit, r); scattered.Direction.Y > 0 {
+				reflected++
+			}
+		}
+		return float64(reflected) / 10000
+	}
+
+	// about 4% of light is reflected head on
+	if head := reflectance(0); math.Abs(head-.04) > .01 {
+		t.Error("normal incidence", head)
+	}
+	if reflectance(85) < reflectance(45) {
+		t.Error("grazing rays reflect less than oblique ones")
+	}
+}
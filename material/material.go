@@ -3,24 +3,48 @@ package material
 import (
 	"goraytracer/geometry"
 	"goraytracer/ray"
+	"goraytracer/texture"
 	"goraytracer/vec3"
+	"math"
 	"math/rand"
 )
 
 type Attenuation = vec3.Vec3
 type ScatterRay = ray.Ray
 
+// A Material describes how a surface scatters light.
+//
+// Scatter samples a direction and returns the color the surface attenuates light by,
+// already divided by the pdf of the sample, and the ray light arrives along.
+// A nil ray means the light was absorbed and ends the path.
+//
+// Emitted is the radiance the surface gives off by itself, independent of scattering.
+// It is not limited to 1: a light brighter than white is brighter than white.
+//
+// Eval and Pdf take unit directions pointing away from the hit point: wo towards the viewer
+// and wi towards the light. Eval returns the BSDF, without the cosine term, and Pdf the density
+// Scatter samples wi with. Perfectly specular materials can only be sampled, so both return 0.
 type Material interface {
-	Scatter(hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay)
+	Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay)
+	Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3
+	Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64
+	Emitted(hitRecord geometry.HitRecord) vec3.Vec3
 }
 
-type Texture struct {
+// textured returns color, multiplied by tex at the hit when there is one.
+// Every color a material has can be textured this way: a white color shows the texture as is.
+func textured(color vec3.Vec3, tex texture.Texture, hitRecord geometry.HitRecord) vec3.Vec3 {
+	if tex == nil {
+		return color
+	}
+	return vec3.Multiply(color, tex.Value(hitRecord.U, hitRecord.V, hitRecord.Point))
 }
 
-// Until PBR model is implemented, assume materials are Lambertian.
+// The properties of a Lambertian surface. See PBR for the glTF metallic roughness model.
+// When BaseColorTexture is set it is tinted by Albedo.
 type MaterialProps struct {
 	Albedo           vec3.Vec3
-	BaseColorTexture *Texture
+	BaseColorTexture texture.Texture
 	EmittanceColor   vec3.Vec3
 }
 
@@ -28,31 +52,33 @@ type Lambertian struct {
 	Properties MaterialProps
 }
 
-// Attenuation doesn't seem so appropriate now that materials can emit light.
-func (material *Lambertian) Scatter(hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay) {
-	black := vec3.Vec3{}
-	if material.Properties.EmittanceColor != black {
-		// emit light and do not scatter.
-		// umm... how can light and baseColorTexture be blended together?
-		// does the blended texture effect the light being emitted? It should.
-
-		//if material.Properties.BaseColorTexture != nil {
-		blendFactor := .2 // 10% image, 90% emitted light color
-		return vec3.Lerp(material.Properties.Albedo, material.Properties.EmittanceColor, blendFactor), nil
-		//}
-	} else {
-
-		scatterDir := vec3.Add(hitRecord.Normal, vec3.RandomInUnitSphere(random).Normalized())
-		if scatterDir.NearZero() {
-			scatterDir = hitRecord.Normal
-		}
-		scatterRay := ray.New(hitRecord.Point, scatterDir)
-
-		//color := vec3.Vec3{
-		//	X: hitRecord.U,
-		//	Y: 0.0,
-		//	Z: hitRecord.V,
-		//}
-		return material.Properties.Albedo, scatterRay
+func (material *Lambertian) albedo(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return textured(material.Properties.Albedo, material.Properties.BaseColorTexture, hitRecord)
+}
+
+func (material *Lambertian) Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay) {
+	scatterDir := vec3.Add(hitRecord.Normal, vec3.RandomInUnitSphere(random).Normalized())
+	if scatterDir.NearZero() {
+		scatterDir = hitRecord.Normal
 	}
+	scatterRay := hitRecord.SpawnRay(scatterDir)
+
+	return material.albedo(hitRecord), scatterRay
+}
+
+// Lights scatter like any other surface, so an emitter can also be lit.
+func (material *Lambertian) Emitted(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return material.Properties.EmittanceColor
+}
+
+func (material *Lambertian) Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3 {
+	if vec3.Dot(wi, hitRecord.Normal) <= 0 || vec3.Dot(wo, hitRecord.Normal) <= 0 {
+		return vec3.Vec3{}
+	}
+	return vec3.MultiplyScalar(material.albedo(hitRecord), 1.0/math.Pi)
+}
+
+// normal plus a random unit vector is cosine distributed
+func (material *Lambertian) Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64 {
+	return math.Max(vec3.Dot(wi, hitRecord.Normal), 0) / math.Pi
 }
@@ -0,0 +1,81 @@
+package material_test
+
+import (
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+)
+
+func TestLambertian_EmitterAlsoScatters(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	light := material.Lambertian{Properties: material.MaterialProps{
+		Albedo:         vec3.Vec3{X: .5, Y: .25, Z: 0},
+		EmittanceColor: vec3.Vec3{X: 4, Y: 4, Z: 4},
+	}}
+	hit := floorHit()
+	incoming := ray.New(vec3.Vec3{Y: 1}, vec3.Vec3{Y: -1})
+
+	// emission is reported as is, brighter than white
+	if emitted := light.Emitted(hit); emitted != light.Properties.EmittanceColor {
+		t.Error(emitted)
+	}
+
+	for i := 0; i < 100; i++ {
+		attenuation, scattered := light.Scatter(incoming, hit, r)
+		if scattered == nil || attenuation != light.Properties.Albedo {
+			t.Fatal(i, attenuation, scattered)
+		}
+	}
+}
+
+func TestNonEmissiveMaterialsEmitNothing(t *testing.T) {
+	hit := floorHit()
+	materials := []material.Material{
+		&material.Lambertian{},
+		&material.Metal{},
+		&material.Dielectric{RefractiveIndex: 1.5},
+		&material.PBR{},
+	}
+
+	for _, m := range materials {
+		if emitted := m.Emitted(hit); emitted != (vec3.Vec3{}) {
+			t.Errorf("%T emits %v", m, emitted)
+		}
+	}
+
+	pbr := material.PBR{Emissive: vec3.Vec3{X: 2, Y: 1}}
+	if emitted := pbr.Emitted(hit); emitted != pbr.Emissive {
+		t.Error(emitted)
+	}
+}
+
+func TestLambertian_TextureTintedByAlbedo(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	img.Set(1, 0, color.NRGBA{A: 255})
+	tex := texture.NewImage(img, texture.Linear)
+	tex.Filter = texture.Nearest
+
+	lambertian := material.Lambertian{Properties: material.MaterialProps{
+		Albedo:           vec3.Vec3{X: 1, Y: .5, Z: .25},
+		BaseColorTexture: tex,
+	}}
+	incoming := ray.New(vec3.Vec3{Y: 1}, vec3.Vec3{Y: -1})
+	r := rand.New(rand.NewSource(0))
+
+	hit := floorHit()
+	hit.U, hit.V = .25, .5
+	if attenuation, _ := lambertian.Scatter(incoming, hit, r); attenuation != lambertian.Properties.Albedo {
+		t.Error("white texel", attenuation)
+	}
+
+	hit.U = .75
+	if attenuation, _ := lambertian.Scatter(incoming, hit, r); attenuation != (vec3.Vec3{}) {
+		t.Error("black texel", attenuation)
+	}
+}
@@ -0,0 +1,47 @@
+package material
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/ray"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+)
+
+// Metal reflects rays about the surface normal.
+// Roughness in [0, 1] fuzzes the reflection, 0 is a perfect mirror.
+type Metal struct {
+	Albedo        vec3.Vec3
+	AlbedoTexture texture.Texture // tinted by Albedo
+	Roughness     float64
+}
+
+func (material *Metal) Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay) {
+	reflected := vec3.Reflect(rayIn.Direction.Normalized(), hitRecord.Normal)
+
+	roughness := math.Min(math.Max(material.Roughness, 0), 1)
+	if roughness > 0 {
+		reflected = vec3.Add(reflected, vec3.MultiplyScalar(vec3.RandomInUnitSphere(random), roughness))
+	}
+
+	// fuzzed below the surface, absorb the ray
+	if vec3.Dot(reflected, hitRecord.Normal) <= 0 {
+		return vec3.Vec3{}, nil
+	}
+
+	return textured(material.Albedo, material.AlbedoTexture, hitRecord), hitRecord.SpawnRay(reflected)
+}
+
+// the fuzzed reflection has no closed form density, so Metal is treated as specular
+func (material *Metal) Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3 {
+	return vec3.Vec3{}
+}
+
+func (material *Metal) Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64 {
+	return 0
+}
+
+func (material *Metal) Emitted(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return vec3.Vec3{}
+}
@@ -0,0 +1,75 @@
+package material_test
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func nearVec3(a vec3.Vec3, b vec3.Vec3) bool {
+	return vec3.Sub(a, b).Length() < .0000001
+}
+
+func floorHit() geometry.HitRecord {
+	return geometry.HitRecord{
+		Hit:    true,
+		Point:  vec3.Vec3{X: 1, Y: 0, Z: 2},
+		Normal: vec3.Vec3{Y: 1},
+	}
+}
+
+func TestMetal_MirrorReflection(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	metal := material.Metal{Albedo: vec3.Vec3{X: .9, Y: .8, Z: .7}}
+	hit := floorHit()
+
+	for i := 0; i < 1000; i++ {
+		direction := vec3.RandomInUnitSphere(r)
+		direction.Y = -math.Abs(direction.Y) - .01
+		incoming := ray.New(vec3.Sub(hit.Point, direction), direction)
+
+		attenuation, scattered := metal.Scatter(incoming, hit, r)
+		if scattered == nil {
+			t.Fatal(i, "absorbed")
+		}
+
+		expected := direction.Normalized()
+		expected.Y = -expected.Y
+		if !nearVec3(scattered.Direction, expected) || scattered.Origin != hit.Point || attenuation != metal.Albedo {
+			t.Fatal(i, scattered.Direction, expected)
+		}
+	}
+}
+
+func TestMetal_RoughReflectionStaysAboveSurface(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	metal := material.Metal{Albedo: vec3.Vec3{X: 1, Y: 1, Z: 1}, Roughness: 1}
+	hit := floorHit()
+
+	// a grazing ray, so that many fuzzed reflections end up below the surface
+	incoming := ray.New(vec3.Vec3{X: -10, Y: .1, Z: 2}, vec3.Vec3{X: 1, Y: -.01, Z: 0})
+
+	absorbed := 0
+	for i := 0; i < 1000; i++ {
+		attenuation, scattered := metal.Scatter(incoming, hit, r)
+		if scattered == nil {
+			if attenuation != (vec3.Vec3{}) {
+				t.Fatal(i, "absorbed ray isn't black", attenuation)
+			}
+			absorbed++
+			continue
+		}
+
+		if vec3.Dot(scattered.Direction, hit.Normal) <= 0 {
+			t.Fatal(i, scattered.Direction)
+		}
+	}
+
+	if absorbed == 0 {
+		t.Error("no grazing ray was absorbed")
+	}
+}
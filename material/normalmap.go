@@ -0,0 +1,143 @@
+package material
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/ray"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math/rand"
+)
+
+// NormalMap perturbs the shading normal of the wrapped Material with a tangent space
+// normal map before it scatters: red along Tangent, green along Bitangent and blue along
+// Normal, each mapped from [0, 1] to [-1, 1]. Load normal maps with texture.Linear.
+//
+// Scale multiplies the red and green channels, like glTF's normalTexture.scale.
+// Zero is treated as 1, so the zero value applies the map as it is.
+type NormalMap struct {
+	Material Material
+	Map      texture.Texture
+	Scale    float64
+}
+
+// BumpMap perturbs the shading normal of the wrapped Material as if the surface were displaced
+// along it by the brightness of Height, Scale times over. Only the slope of Height matters.
+// Zero is treated as 1, like NormalMap's Scale.
+type BumpMap struct {
+	Material Material
+	Height   texture.Texture
+	Scale    float64
+}
+
+// distance in UV the slope of a bump map is measured over
+const bumpDelta = 1e-3
+
+// withNormal returns hitRecord shaded with normal instead, as long as normal stays on the
+// same side of the surface as the geometric normal. The tangent frame follows the new normal.
+func withNormal(hitRecord geometry.HitRecord, normal vec3.Vec3) geometry.HitRecord {
+	if normal.NearZero() {
+		return hitRecord
+	}
+	normal = normal.Normalized()
+	if vec3.Dot(normal, hitRecord.GeometricNormal) <= 0 {
+		return hitRecord
+	}
+
+	tangent := vec3.Sub(hitRecord.Tangent, vec3.MultiplyScalar(normal, vec3.Dot(hitRecord.Tangent, normal)))
+	if tangent.NearZero() {
+		return hitRecord
+	}
+	tangent = tangent.Normalized()
+
+	bitangent := vec3.Cross(normal, tangent)
+	if vec3.Dot(bitangent, hitRecord.Bitangent) < 0 {
+		bitangent = vec3.MultiplyScalar(bitangent, -1)
+	}
+
+	hitRecord.Normal = normal
+	hitRecord.Tangent = tangent
+	hitRecord.Bitangent = bitangent
+	return hitRecord
+}
+
+// returns the hit with the normal map applied. Hits without a tangent frame are left as they are.
+func (material *NormalMap) perturb(hitRecord geometry.HitRecord) geometry.HitRecord {
+	if hitRecord.Tangent == (vec3.Vec3{}) || material.Map == nil {
+		return hitRecord
+	}
+
+	scale := scaleOrOne(material.Scale)
+	c := material.Map.Value(hitRecord.U, hitRecord.V, hitRecord.Point)
+	x := (2*c.X - 1) * scale
+	y := (2*c.Y - 1) * scale
+	z := 2*c.Z - 1
+
+	normal := vec3.Add(
+		vec3.Add(vec3.MultiplyScalar(hitRecord.Tangent, x), vec3.MultiplyScalar(hitRecord.Bitangent, y)),
+		vec3.MultiplyScalar(hitRecord.Normal, z),
+	)
+	return withNormal(hitRecord, normal)
+}
+
+// the zero value of a map's Scale means 1
+func scaleOrOne(scale float64) float64 {
+	if scale == 0 {
+		return 1
+	}
+	return scale
+}
+
+func brightness(c vec3.Vec3) float64 {
+	return (c.X + c.Y + c.Z) / 3
+}
+
+// returns the hit with the bump map applied. Hits without a tangent frame are left as they are.
+func (material *BumpMap) perturb(hitRecord geometry.HitRecord) geometry.HitRecord {
+	if hitRecord.Tangent == (vec3.Vec3{}) || material.Height == nil {
+		return hitRecord
+	}
+
+	u, v, p := hitRecord.U, hitRecord.V, hitRecord.Point
+	height := brightness(material.Height.Value(u, v, p))
+
+	// the slope of the height field along U and V, by forward differences
+	dhdu := (brightness(material.Height.Value(u+bumpDelta, v, p)) - height) / bumpDelta
+	dhdv := (brightness(material.Height.Value(u, v+bumpDelta, p)) - height) / bumpDelta
+
+	// the normal tilts away from the way the surface rises
+	slope := vec3.Add(vec3.MultiplyScalar(hitRecord.Tangent, dhdu), vec3.MultiplyScalar(hitRecord.Bitangent, dhdv))
+	normal := vec3.Sub(hitRecord.Normal, vec3.MultiplyScalar(slope, scaleOrOne(material.Scale)))
+	return withNormal(hitRecord, normal)
+}
+
+func (material *NormalMap) Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay) {
+	return material.Material.Scatter(rayIn, material.perturb(hitRecord), random)
+}
+
+func (material *NormalMap) Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3 {
+	return material.Material.Eval(material.perturb(hitRecord), wo, wi)
+}
+
+func (material *NormalMap) Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64 {
+	return material.Material.Pdf(material.perturb(hitRecord), wo, wi)
+}
+
+func (material *NormalMap) Emitted(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return material.Material.Emitted(hitRecord)
+}
+
+func (material *BumpMap) Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay) {
+	return material.Material.Scatter(rayIn, material.perturb(hitRecord), random)
+}
+
+func (material *BumpMap) Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3 {
+	return material.Material.Eval(material.perturb(hitRecord), wo, wi)
+}
+
+func (material *BumpMap) Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64 {
+	return material.Material.Pdf(material.perturb(hitRecord), wo, wi)
+}
+
+func (material *BumpMap) Emitted(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return material.Material.Emitted(hitRecord)
+}
@@ -0,0 +1,165 @@
+package material_test
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// records the hit it is asked to scatter
+type recorder struct {
+	hit geometry.HitRecord
+}
+
+func (r *recorder) Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (material.Attenuation, *material.ScatterRay) {
+	r.hit = hitRecord
+	return vec3.Vec3{}, nil
+}
+
+func (r *recorder) Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3 {
+	r.hit = hitRecord
+	return vec3.Vec3{}
+}
+
+func (r *recorder) Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64 {
+	r.hit = hitRecord
+	return 0
+}
+
+func (r *recorder) Emitted(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return vec3.Vec3{X: 3}
+}
+
+// a floor hit with a tangent frame: U grows along +X and V along -Z
+func framedHit() geometry.HitRecord {
+	hit := floorHit()
+	hit.GeometricNormal = hit.Normal
+	hit.Tangent = vec3.Vec3{X: 1}
+	hit.Bitangent = vec3.Vec3{Z: -1}
+	hit.U, hit.V = .5, .5
+	return hit
+}
+
+// the scattered normal after wrapping recorded with m
+func perturbedHit(m material.Material, recorded *recorder, hit geometry.HitRecord) geometry.HitRecord {
+	m.Scatter(ray.New(vec3.Vec3{Y: 1}, vec3.Vec3{Y: -1}), hit, rand.New(rand.NewSource(0)))
+	return recorded.hit
+}
+
+// the height or color grows linearly with U
+type uRamp struct{}
+
+func (uRamp) Value(u float64, v float64, p vec3.Vec3) vec3.Vec3 {
+	return vec3.Vec3{X: u, Y: u, Z: u}
+}
+
+func TestNormalMap_FlatMapKeepsNormal(t *testing.T) {
+	recorded := &recorder{}
+	flat := material.NormalMap{Material: recorded, Map: texture.Solid{Color: vec3.Vec3{X: .5, Y: .5, Z: 1}}}
+
+	hit := perturbedHit(&flat, recorded, framedHit())
+	if !nearVec3(hit.Normal, vec3.Vec3{Y: 1}) {
+		t.Error(hit.Normal)
+	}
+}
+
+func TestNormalMap_TiltsAlongTangentFrame(t *testing.T) {
+	recorded := &recorder{}
+	// 45 degrees towards the tangent, then towards the bitangent
+	tangentward := material.NormalMap{Material: recorded, Map: texture.Solid{Color: vec3.Vec3{X: 1, Y: .5, Z: 1}}}
+	bitangentward := material.NormalMap{Material: recorded, Map: texture.Solid{Color: vec3.Vec3{X: .5, Y: 1, Z: 1}}}
+
+	hit := perturbedHit(&tangentward, recorded, framedHit())
+	if !nearVec3(hit.Normal, vec3.Vec3{X: 1, Y: 1}.Normalized()) {
+		t.Error("tangent", hit.Normal)
+	}
+	// the frame stays orthonormal
+	if math.Abs(vec3.Dot(hit.Normal, hit.Tangent)) > 1e-9 || math.Abs(vec3.Dot(hit.Normal, hit.Bitangent)) > 1e-9 ||
+		math.Abs(hit.Tangent.Length()-1) > 1e-9 || math.Abs(hit.Bitangent.Length()-1) > 1e-9 {
+		t.Error("frame", hit.Normal, hit.Tangent, hit.Bitangent)
+	}
+
+	hit = perturbedHit(&bitangentward, recorded, framedHit())
+	if !nearVec3(hit.Normal, vec3.Vec3{Y: 1, Z: -1}.Normalized()) {
+		t.Error("bitangent", hit.Normal)
+	}
+
+	// the geometric normal is never touched
+	if hit.GeometricNormal != (vec3.Vec3{Y: 1}) {
+		t.Error(hit.GeometricNormal)
+	}
+}
+
+func TestNormalMap_NeedsTangentFrame(t *testing.T) {
+	recorded := &recorder{}
+	tilted := material.NormalMap{Material: recorded, Map: texture.Solid{Color: vec3.Vec3{X: 1, Y: .5, Z: 1}}}
+
+	hit := framedHit()
+	hit.Tangent, hit.Bitangent = vec3.Vec3{}, vec3.Vec3{}
+	if got := perturbedHit(&tilted, recorded, hit); got.Normal != hit.Normal {
+		t.Error(got.Normal)
+	}
+}
+
+func TestNormalMap_StaysAboveSurface(t *testing.T) {
+	recorded := &recorder{}
+	// points below the surface
+	flipped := material.NormalMap{Material: recorded, Map: texture.Solid{Color: vec3.Vec3{X: .5, Y: .5, Z: 0}}}
+
+	if hit := perturbedHit(&flipped, recorded, framedHit()); hit.Normal != (vec3.Vec3{Y: 1}) {
+		t.Error(hit.Normal)
+	}
+}
+
+func TestBumpMap_TiltsAwayFromSlope(t *testing.T) {
+	recorded := &recorder{}
+	bumped := material.BumpMap{Material: recorded, Height: uRamp{}, Scale: 1}
+
+	// the height rises by one per unit of U, along +X, so the normal leans back towards -X by 45 degrees
+	hit := perturbedHit(&bumped, recorded, framedHit())
+	if !nearVec3(hit.Normal, vec3.Vec3{X: -1, Y: 1}.Normalized()) {
+		t.Error(hit.Normal)
+	}
+
+	// a zero scale means 1, as it does for normal maps
+	unscaled := material.BumpMap{Material: recorded, Height: uRamp{}}
+	if hit := perturbedHit(&unscaled, recorded, framedHit()); !nearVec3(hit.Normal, vec3.Vec3{X: -1, Y: 1}.Normalized()) {
+		t.Error("zero scale", hit.Normal)
+	}
+
+	flat := material.BumpMap{Material: recorded, Height: texture.Solid{Color: vec3.Vec3{X: .3, Y: .3, Z: .3}}, Scale: 5}
+	if hit := perturbedHit(&flat, recorded, framedHit()); !nearVec3(hit.Normal, vec3.Vec3{Y: 1}) {
+		t.Error("constant height", hit.Normal)
+	}
+}
+
+func TestMapsDelegateToWrappedMaterial(t *testing.T) {
+	recorded := &recorder{}
+	wrapped := []material.Material{
+		&material.NormalMap{Material: recorded, Map: uRamp{}},
+		&material.BumpMap{Material: recorded, Height: uRamp{}, Scale: 1},
+	}
+
+	for _, m := range wrapped {
+		if emitted := m.Emitted(framedHit()); emitted != (vec3.Vec3{X: 3}) {
+			t.Errorf("%T: Emitted() = %v", m, emitted)
+		}
+
+		recorded.hit = geometry.HitRecord{}
+		m.Eval(framedHit(), vec3.Vec3{Y: 1}, vec3.Vec3{Y: 1})
+		if !recorded.hit.Hit {
+			t.Errorf("%T: Eval wasn't delegated", m)
+		}
+
+		recorded.hit = geometry.HitRecord{}
+		m.Pdf(framedHit(), vec3.Vec3{Y: 1}, vec3.Vec3{Y: 1})
+		if !recorded.hit.Hit {
+			t.Errorf("%T: Pdf wasn't delegated", m)
+		}
+	}
+}
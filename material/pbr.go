@@ -0,0 +1,223 @@
+package material
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/ray"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+)
+
+// PBR is the glTF 2.0 metallic roughness material: a Lambertian diffuse base under a
+// GGX microfacet specular layer, with metals tinting their reflection by BaseColor.
+// https://registry.khronos.org/glTF/specs/2.0/glTF-2.0.html#appendix-b-brdf-implementation
+type PBR struct {
+	BaseColor vec3.Vec3
+
+	// multiplied with BaseColor and Emissive when set
+	BaseColorTexture texture.Texture
+	EmissiveTexture  texture.Texture
+
+	// when set, its green channel multiplies Roughness and its blue channel Metallic, as in glTF
+	MetallicRoughnessTexture texture.Texture
+
+	Metallic  float64 // 0 dielectric, 1 metal
+	Roughness float64 // perceptual roughness, squared to get the GGX alpha
+	Emissive  vec3.Vec3
+}
+
+// below this alpha the GGX distribution is too sharp to evaluate reliably
+const minAlpha = 0.001
+
+// dielectrics reflect about 4% of light head on
+var dielectricF0 = vec3.Vec3{X: .04, Y: .04, Z: .04}
+
+// the texel of MetallicRoughnessTexture at the hit, white without one
+func (material *PBR) metallicRoughness(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return textured(vec3.Vec3{X: 1, Y: 1, Z: 1}, material.MetallicRoughnessTexture, hitRecord)
+}
+
+func (material *PBR) alpha(hitRecord geometry.HitRecord) float64 {
+	roughness := math.Min(math.Max(material.Roughness*material.metallicRoughness(hitRecord).Y, 0), 1)
+	return math.Max(roughness*roughness, minAlpha)
+}
+
+func (material *PBR) metallic(hitRecord geometry.HitRecord) float64 {
+	return math.Min(math.Max(material.Metallic*material.metallicRoughness(hitRecord).Z, 0), 1)
+}
+
+func (material *PBR) baseColor(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return textured(material.BaseColor, material.BaseColorTexture, hitRecord)
+}
+
+func f0(baseColor vec3.Vec3, metallic float64) vec3.Vec3 {
+	return vec3.Lerp(dielectricF0, baseColor, metallic)
+}
+
+func schlickFresnel(f0 vec3.Vec3, cosine float64) vec3.Vec3 {
+	weight := math.Pow(1-math.Min(math.Max(cosine, 0), 1), 5)
+	return vec3.Lerp(f0, vec3.Vec3{X: 1, Y: 1, Z: 1}, weight)
+}
+
+// GGX normal distribution
+func ggxD(nDotH float64, alpha float64) float64 {
+	alpha2 := alpha * alpha
+	d := nDotH*nDotH*(alpha2-1) + 1
+	return alpha2 / (math.Pi * d * d)
+}
+
+// Smith masking of a single direction
+func smithG1(nDotV float64, alpha float64) float64 {
+	alpha2 := alpha * alpha
+	return 2 * nDotV / (nDotV + math.Sqrt(alpha2+(1-alpha2)*nDotV*nDotV))
+}
+
+// height correlated Smith visibility, G / (4 n.l n.v)
+func smithVisibility(nDotL float64, nDotV float64, alpha float64) float64 {
+	alpha2 := alpha * alpha
+	l := nDotV * math.Sqrt(nDotL*nDotL*(1-alpha2)+alpha2)
+	v := nDotL * math.Sqrt(nDotV*nDotV*(1-alpha2)+alpha2)
+	return 0.5 / (l + v)
+}
+
+func average(v vec3.Vec3) float64 {
+	return (v.X + v.Y + v.Z) / 3
+}
+
+// the probability of sampling the specular lobe rather than the diffuse one,
+// in proportion to the energy each is expected to reflect
+func (material *PBR) specularProbability(hitRecord geometry.HitRecord, nDotV float64) float64 {
+	baseColor := material.baseColor(hitRecord)
+	metallic := material.metallic(hitRecord)
+	specular := average(schlickFresnel(f0(baseColor, metallic), nDotV))
+	diffuse := (1 - metallic) * (1 - specular) * average(baseColor)
+	if specular+diffuse == 0 {
+		// a black metal seen head on reflects nothing, either lobe will do
+		return 1
+	}
+	return specular / (specular + diffuse)
+}
+
+func (material *PBR) Eval(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) vec3.Vec3 {
+	n := hitRecord.Normal
+	nDotV := vec3.Dot(n, wo)
+	nDotL := vec3.Dot(n, wi)
+	if nDotV <= 0 || nDotL <= 0 {
+		return vec3.Vec3{}
+	}
+
+	h := vec3.Add(wo, wi).Normalized()
+	alpha := material.alpha(hitRecord)
+	baseColor := material.baseColor(hitRecord)
+	metallic := material.metallic(hitRecord)
+	fresnel := schlickFresnel(f0(baseColor, metallic), vec3.Dot(wo, h))
+
+	specular := vec3.MultiplyScalar(fresnel, ggxD(vec3.Dot(n, h), alpha)*smithVisibility(nDotL, nDotV, alpha))
+
+	diffuseColor := vec3.MultiplyScalar(baseColor, (1-metallic)/math.Pi)
+	diffuse := vec3.Multiply(vec3.Sub(vec3.Vec3{X: 1, Y: 1, Z: 1}, fresnel), diffuseColor)
+
+	return vec3.Add(diffuse, specular)
+}
+
+func (material *PBR) Pdf(hitRecord geometry.HitRecord, wo vec3.Vec3, wi vec3.Vec3) float64 {
+	n := hitRecord.Normal
+	nDotV := vec3.Dot(n, wo)
+	nDotL := vec3.Dot(n, wi)
+	if nDotV <= 0 || nDotL <= 0 {
+		return 0
+	}
+
+	// density of reflecting about a visible normal: D_v(h) / (4 v.h) = G1(v) D(h) / (4 n.v)
+	h := vec3.Add(wo, wi).Normalized()
+	alpha := material.alpha(hitRecord)
+	specularPdf := smithG1(nDotV, alpha) * ggxD(vec3.Dot(n, h), alpha) / (4 * nDotV)
+	diffusePdf := nDotL / math.Pi
+
+	p := material.specularProbability(hitRecord, nDotV)
+	return p*specularPdf + (1-p)*diffusePdf
+}
+
+// returns two unit vectors that form a right handed basis with the unit vector n
+// https://graphics.pixar.com/library/OrthonormalB/paper.pdf
+func orthonormalBasis(n vec3.Vec3) (vec3.Vec3, vec3.Vec3) {
+	sign := math.Copysign(1, n.Z)
+	a := -1 / (sign + n.Z)
+	b := n.X * n.Y * a
+	return vec3.Vec3{X: 1 + sign*n.X*n.X*a, Y: sign * b, Z: -sign * n.X},
+		vec3.Vec3{X: b, Y: sign + n.Y*n.Y*a, Z: -n.Y}
+}
+
+// sampleVisibleNormal samples a microfacet normal from the distribution of normals visible
+// from v, given in a frame where the surface normal is +Z.
+// https://jcgt.org/published/0007/04/01/
+func sampleVisibleNormal(v vec3.Vec3, alpha float64, u1 float64, u2 float64) vec3.Vec3 {
+	// stretch the view direction to the hemisphere configuration
+	vh := vec3.Vec3{X: alpha * v.X, Y: alpha * v.Y, Z: v.Z}.Normalized()
+
+	lengthSquared := vh.X*vh.X + vh.Y*vh.Y
+	t1 := vec3.Vec3{X: 1}
+	if lengthSquared > 0 {
+		t1 = vec3.MultiplyScalar(vec3.Vec3{X: -vh.Y, Y: vh.X}, 1/math.Sqrt(lengthSquared))
+	}
+	t2 := vec3.Cross(vh, t1)
+
+	// sample the projected area of the visible hemisphere
+	r := math.Sqrt(u1)
+	phi := 2 * math.Pi * u2
+	p1 := r * math.Cos(phi)
+	p2 := r * math.Sin(phi)
+	s := 0.5 * (1 + vh.Z)
+	p2 = (1-s)*math.Sqrt(1-p1*p1) + s*p2
+
+	nh := vec3.Add(
+		vec3.Add(vec3.MultiplyScalar(t1, p1), vec3.MultiplyScalar(t2, p2)),
+		vec3.MultiplyScalar(vh, math.Sqrt(math.Max(0, 1-p1*p1-p2*p2))),
+	)
+
+	// unstretch
+	return vec3.Vec3{X: alpha * nh.X, Y: alpha * nh.Y, Z: math.Max(0, nh.Z)}.Normalized()
+}
+
+func (material *PBR) Scatter(rayIn *ray.Ray, hitRecord geometry.HitRecord, random *rand.Rand) (Attenuation, *ScatterRay) {
+	n := hitRecord.Normal
+	wo := vec3.MultiplyScalar(rayIn.Direction.Normalized(), -1)
+	nDotV := vec3.Dot(n, wo)
+	if nDotV <= 0 {
+		return vec3.Vec3{}, nil
+	}
+
+	var wi vec3.Vec3
+	if random.Float64() < material.specularProbability(hitRecord, nDotV) {
+		tangent, bitangent := orthonormalBasis(n)
+		local := vec3.Vec3{X: vec3.Dot(wo, tangent), Y: vec3.Dot(wo, bitangent), Z: nDotV}
+		m := sampleVisibleNormal(local, material.alpha(hitRecord), random.Float64(), random.Float64())
+		h := vec3.Add(vec3.Add(vec3.MultiplyScalar(tangent, m.X), vec3.MultiplyScalar(bitangent, m.Y)), vec3.MultiplyScalar(n, m.Z))
+		wi = vec3.Reflect(vec3.MultiplyScalar(wo, -1), h)
+	} else {
+		wi = vec3.Add(n, vec3.RandomInUnitSphere(random).Normalized())
+		if wi.NearZero() {
+			wi = n
+		}
+		wi = wi.Normalized()
+	}
+
+	// reflected below the surface
+	nDotL := vec3.Dot(n, wi)
+	if nDotL <= 0 {
+		return vec3.Vec3{}, nil
+	}
+
+	pdf := material.Pdf(hitRecord, wo, wi)
+	if pdf <= 0 {
+		return vec3.Vec3{}, nil
+	}
+
+	weight := vec3.MultiplyScalar(material.Eval(hitRecord, wo, wi), nDotL/pdf)
+	return weight, hitRecord.SpawnRay(wi)
+}
+
+func (material *PBR) Emitted(hitRecord geometry.HitRecord) vec3.Vec3 {
+	return textured(material.Emissive, material.EmissiveTexture, hitRecord)
+}
@@ -0,0 +1,196 @@
+package material_test
+
+import (
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func pbrMaterials() []material.PBR {
+	var materials []material.PBR
+	for _, metallic := range []float64{0, .5, 1} {
+		for _, roughness := range []float64{.1, .5, 1} {
+			materials = append(materials, material.PBR{
+				BaseColor: vec3.Vec3{X: 1, Y: 1, Z: 1},
+				Metallic:  metallic,
+				Roughness: roughness,
+			})
+		}
+	}
+	return materials
+}
+
+// a direction above the floor y = 0
+func randomAbove(r *rand.Rand) vec3.Vec3 {
+	v := vec3.RandomInUnitSphere(r).Normalized()
+	v.Y = math.Abs(v.Y)
+	return v
+}
+
+func TestPBR_EvalIsReciprocal(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	hit := floorHit()
+
+	for _, pbr := range pbrMaterials() {
+		for i := 0; i < 1000; i++ {
+			wo := randomAbove(r)
+			wi := randomAbove(r)
+			if !nearVec3(pbr.Eval(hit, wo, wi), pbr.Eval(hit, wi, wo)) {
+				t.Fatal(pbr, wo, wi)
+			}
+		}
+	}
+}
+
+// the weights returned by Scatter estimate the directional albedo, which a white
+// surface can't push above one without creating energy.
+// Single scattering GGX loses energy on rough surfaces, so there is no lower bound.
+func TestPBR_WhiteFurnaceConservesEnergy(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	hit := floorHit()
+
+	for _, pbr := range pbrMaterials() {
+		angles := []float64{0, 45, 80}
+		if pbr.Metallic < 1 {
+			// glTF's fresnel mix of diffuse and specular reflects more than it receives
+			// at grazing angles, by design of the spec rather than a sampling error
+			angles = []float64{0, 45}
+		}
+
+		for _, angle := range angles {
+			incoming, _ := angledHit(angle, true)
+
+			const samples = 20000
+			total := vec3.Vec3{}
+			for i := 0; i < samples; i++ {
+				attenuation, scattered := pbr.Scatter(incoming, hit, r)
+				if scattered != nil && vec3.Dot(scattered.Direction, hit.Normal) <= 0 {
+					t.Fatal(pbr, "scattered below the surface")
+				}
+				total = vec3.Add(total, attenuation)
+			}
+
+			albedo := total.X / samples
+			if albedo > 1.02 {
+				t.Error(pbr, angle, albedo)
+			}
+		}
+	}
+}
+
+// sampling with Scatter and weighting by 1 / Pdf integrates one over the hemisphere to 2 pi,
+// which only holds when Pdf is the density Scatter actually samples with
+func TestPBR_PdfMatchesSampling(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	hit := floorHit()
+	pbr := material.PBR{BaseColor: vec3.Vec3{X: .8, Y: .5, Z: .2}, Roughness: .4}
+	incoming, _ := angledHit(30, true)
+	wo := vec3.MultiplyScalar(incoming.Direction.Normalized(), -1)
+
+	const samples = 200000
+	integral := 0.0
+	for i := 0; i < samples; i++ {
+		_, scattered := pbr.Scatter(incoming, hit, r)
+		if scattered == nil {
+			continue
+		}
+		integral += 1 / pbr.Pdf(hit, wo, scattered.Direction)
+	}
+	integral /= samples
+
+	if math.Abs(integral-2*math.Pi)/(2*math.Pi) > .02 {
+		t.Error(integral, 2*math.Pi)
+	}
+}
+
+func TestPBR_SmoothMetalIsNearlyAMirror(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	hit := floorHit()
+	pbr := material.PBR{BaseColor: vec3.Vec3{X: 1, Y: .8, Z: .6}, Metallic: 1}
+	incoming, _ := angledHit(40, true)
+
+	expected := incoming.Direction
+	expected.Y = -expected.Y
+
+	// GGX has long tails, so a few samples stray even at the lowest roughness
+	strays := 0
+	for i := 0; i < 1000; i++ {
+		attenuation, scattered := pbr.Scatter(incoming, hit, r)
+		if scattered == nil {
+			t.Fatal(i, "absorbed")
+		}
+		if vec3.Sub(scattered.Direction, expected).Length() > .05 {
+			strays++
+		}
+		// metals tint their reflection
+		if math.Abs(attenuation.Y/attenuation.X-.8) > .05 {
+			t.Fatal(i, attenuation)
+		}
+	}
+
+	if strays > 10 {
+		t.Error(strays, "of 1000 samples are not mirror reflections")
+	}
+}
+
+func TestPBR_BelowSurfaceIsBlack(t *testing.T) {
+	hit := geometry.HitRecord{Hit: true, Normal: vec3.Vec3{Y: 1}}
+	pbr := material.PBR{BaseColor: vec3.Vec3{X: 1, Y: 1, Z: 1}, Roughness: .5}
+	above := vec3.Vec3{Y: 1}
+	below := vec3.Vec3{X: 1, Y: -1}.Normalized()
+
+	if pbr.Eval(hit, above, below) != (vec3.Vec3{}) || pbr.Pdf(hit, above, below) != 0 {
+		t.Error("light below the surface is reflected")
+	}
+
+	if _, scattered := pbr.Scatter(ray.New(vec3.Vec3{}, vec3.Vec3{Y: 1}), hit, rand.New(rand.NewSource(0))); scattered != nil {
+		t.Error("scattered a ray arriving from below")
+	}
+}
+
+// a black metal seen head on reflects nothing through either lobe
+func TestPBR_BlackMetalHeadOnIsNotNaN(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	hit := floorHit()
+	pbr := material.PBR{Metallic: 1, Roughness: .5}
+	incoming, _ := angledHit(0, true)
+	wo := vec3.Vec3{Y: 1}
+
+	for i := 0; i < 1000; i++ {
+		if pdf := pbr.Pdf(hit, wo, randomAbove(r)); math.IsNaN(pdf) {
+			t.Fatal(i, "pdf is NaN")
+		}
+
+		attenuation, _ := pbr.Scatter(incoming, hit, r)
+		if math.IsNaN(attenuation.X) || math.IsNaN(attenuation.Y) || math.IsNaN(attenuation.Z) {
+			t.Fatal(i, attenuation)
+		}
+	}
+}
+
+func TestPBR_MetallicRoughnessTextureScalesFactors(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	hit := floorHit()
+
+	// green halves the roughness, no blue makes the metal a dielectric
+	textured := material.PBR{
+		BaseColor:                vec3.Vec3{X: 1, Y: .5, Z: .2},
+		Metallic:                 1,
+		Roughness:                .8,
+		MetallicRoughnessTexture: &texture.Solid{Color: vec3.Vec3{X: 1, Y: .5}},
+	}
+	plain := material.PBR{BaseColor: textured.BaseColor, Metallic: 0, Roughness: .4}
+
+	for i := 0; i < 100; i++ {
+		wo := randomAbove(r)
+		wi := randomAbove(r)
+		if !nearVec3(textured.Eval(hit, wo, wi), plain.Eval(hit, wo, wi)) || math.Abs(textured.Pdf(hit, wo, wi)-plain.Pdf(hit, wo, wi)) > 1e-9 {
+			t.Fatal(wo, wi)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package obj
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// files opens an OBJ file's material libraries and textures, which it names with
+// slash separated paths relative to itself
+type files interface {
+	open(name string) (io.ReadCloser, error)
+	dir(name string) string
+	join(dir string, name string) string
+}
+
+// the operating system's files
+type osFiles struct{}
+
+func (osFiles) open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+func (osFiles) dir(name string) string {
+	return filepath.Dir(name)
+}
+
+func (osFiles) join(dir string, name string) string {
+	return filepath.Join(dir, filepath.FromSlash(name))
+}
+
+// the files of an fs.FS, like an embedded file system
+type fsFiles struct {
+	fsys fs.FS
+}
+
+func (f fsFiles) open(name string) (io.ReadCloser, error) {
+	return f.fsys.Open(name)
+}
+
+func (fsFiles) dir(name string) string {
+	return path.Dir(name)
+}
+
+func (fsFiles) join(dir string, name string) string {
+	return path.Join(dir, name)
+}
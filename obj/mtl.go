@@ -0,0 +1,380 @@
+package obj
+
+import (
+	"bufio"
+	"fmt"
+	"goraytracer/material"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"image"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// TextureMap is a map_ statement: a texture file with the options we understand.
+type TextureMap struct {
+	Filename  string  // relative to the MTL file
+	BumpScale float64 // -bm, 1 unless given
+	Clamp     bool    // -clamp on
+}
+
+// MTL is a material as an MTL file describes it, before it is mapped onto our materials.
+// Fields keep the MTL names.
+type MTL struct {
+	Name string
+
+	Kd vec3.Vec3 // diffuse color
+	Ks vec3.Vec3 // specular color
+	Ke vec3.Vec3 // emitted color
+	Ns float64   // specular exponent, 0 to 1000
+	Ni float64   // index of refraction
+	D  float64   // dissolve, 1 is opaque
+
+	Illum int // illumination model
+
+	// the PBR extension, negative when not given
+	Pr float64 // roughness
+	Pm float64 // metallic
+
+	MapKd   *TextureMap
+	MapKe   *TextureMap
+	Bump    *TextureMap // map_Bump or bump, a height map
+	NormMap *TextureMap // norm, a tangent space normal map
+}
+
+func newMTL(name string) *MTL {
+	return &MTL{
+		Name: name,
+		Kd:   vec3.Vec3{X: .8, Y: .8, Z: .8},
+		Ni:   1,
+		D:    1,
+		Pr:   -1,
+		Pm:   -1,
+	}
+}
+
+// DefaultMaterial is the light gray Lambertian objects without a material get.
+func DefaultMaterial() material.Material {
+	return &material.Lambertian{Properties: material.MaterialProps{Albedo: vec3.Vec3{X: .8, Y: .8, Z: .8}}}
+}
+
+type mtlParser struct {
+	filename string
+	line     int
+}
+
+func (p *mtlParser) errorf(format string, args ...interface{}) error {
+	return &ParseError{Filename: p.filename, Line: p.line, Err: fmt.Sprintf(format, args...)}
+}
+
+func (p *mtlParser) parseFloat(args []string) (float64, error) {
+	if len(args) != 1 {
+		return 0, p.errorf("expected a number, got %d arguments", len(args))
+	}
+	value, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return 0, p.errorf("invalid number %q", args[0])
+	}
+	return value, nil
+}
+
+// colors are three numbers, or one for gray. The spectral and xyz forms are not supported.
+func (p *mtlParser) parseColor(args []string) (vec3.Vec3, error) {
+	if len(args) != 1 && len(args) != 3 {
+		return vec3.Vec3{}, p.errorf("expected 1 or 3 color components, got %d", len(args))
+	}
+
+	values := make([]float64, len(args))
+	for i, arg := range args {
+		value, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return vec3.Vec3{}, p.errorf("invalid color component %q", arg)
+		}
+		values[i] = value
+	}
+
+	if len(values) == 1 {
+		return vec3.Vec3{X: values[0], Y: values[0], Z: values[0]}, nil
+	}
+	return vec3.Vec3{X: values[0], Y: values[1], Z: values[2]}, nil
+}
+
+// the number of arguments each texture option takes, -o, -s and -t take up to 3
+var textureOptions = map[string]int{
+	"-blendu": 1, "-blendv": 1, "-bm": 1, "-boost": 1, "-cc": 1, "-clamp": 1,
+	"-imfchan": 1, "-mm": 2, "-o": 3, "-s": 3, "-t": 3, "-texres": 1, "-type": 1,
+}
+
+func (p *mtlParser) parseTextureMap(args []string) (*TextureMap, error) {
+	textureMap := &TextureMap{BumpScale: 1}
+
+	i := 0
+	for i < len(args) && strings.HasPrefix(args[i], "-") {
+		option := args[i]
+		count, ok := textureOptions[option]
+		if !ok {
+			return nil, p.errorf("unknown texture option %q", option)
+		}
+		i++
+
+		if option == "-o" || option == "-s" || option == "-t" {
+			// one to three numbers
+			taken := 0
+			for taken < count && i < len(args) {
+				if _, err := strconv.ParseFloat(args[i], 64); err != nil {
+					break
+				}
+				i++
+				taken++
+			}
+			if taken == 0 {
+				return nil, p.errorf("texture option %s expects a number", option)
+			}
+			continue
+		}
+
+		if i+count > len(args) {
+			return nil, p.errorf("texture option %s expects %d arguments", option, count)
+		}
+		switch option {
+		case "-bm":
+			scale, err := strconv.ParseFloat(args[i], 64)
+			if err != nil {
+				return nil, p.errorf("invalid bump multiplier %q", args[i])
+			}
+			textureMap.BumpScale = scale
+		case "-clamp":
+			textureMap.Clamp = args[i] == "on"
+		}
+		i += count
+	}
+
+	if i == len(args) {
+		return nil, p.errorf("texture map without a file name")
+	}
+	// file names may contain spaces
+	textureMap.Filename = strings.Join(args[i:], " ")
+	return textureMap, nil
+}
+
+// ParseMTL reads the materials of an MTL file, keyed by name.
+// Statements we don't map onto materials are skipped. filename is only used in error messages.
+func ParseMTL(r io.Reader, filename string) (map[string]*MTL, error) {
+	p := mtlParser{filename: filename}
+	materials := make(map[string]*MTL)
+	var current *MTL
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		p.line++
+		statement, args := fields(scanner.Text())
+		if statement == "" {
+			continue
+		}
+
+		if statement == "newmtl" {
+			if len(args) != 1 {
+				return nil, p.errorf("newmtl expects one material name, got %d", len(args))
+			}
+			current = newMTL(args[0])
+			materials[current.Name] = current
+			continue
+		}
+
+		if current == nil {
+			return nil, p.errorf("%s before newmtl", statement)
+		}
+
+		var err error
+		switch statement {
+		case "Kd":
+			current.Kd, err = p.parseColor(args)
+		case "Ks":
+			current.Ks, err = p.parseColor(args)
+		case "Ke":
+			current.Ke, err = p.parseColor(args)
+		case "Ns":
+			current.Ns, err = p.parseFloat(args)
+		case "Ni":
+			current.Ni, err = p.parseFloat(args)
+		case "d":
+			current.D, err = p.parseFloat(args)
+		case "Tr":
+			var transparency float64
+			transparency, err = p.parseFloat(args)
+			current.D = 1 - transparency
+		case "Pr":
+			current.Pr, err = p.parseFloat(args)
+		case "Pm":
+			current.Pm, err = p.parseFloat(args)
+		case "illum":
+			if len(args) != 1 {
+				err = p.errorf("illum expects one model number, got %d arguments", len(args))
+			} else if current.Illum, err = strconv.Atoi(args[0]); err != nil {
+				err = p.errorf("invalid illumination model %q", args[0])
+			}
+		case "map_Kd":
+			current.MapKd, err = p.parseTextureMap(args)
+		case "map_Ke":
+			current.MapKe, err = p.parseTextureMap(args)
+		case "map_Bump", "map_bump", "bump":
+			current.Bump, err = p.parseTextureMap(args)
+		case "norm":
+			current.NormMap, err = p.parseTextureMap(args)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("obj: reading %s: %w", filename, err)
+	}
+
+	return materials, nil
+}
+
+// roughness for a Blinn-Phong specular exponent, matching the width of the highlight
+func roughnessFromExponent(ns float64) float64 {
+	return math.Min(math.Sqrt(2/(math.Max(ns, 0)+2)), 1)
+}
+
+// loads the textures of a directory's materials once each
+type textureLoader struct {
+	files  files
+	dir    string
+	images map[string]*texture.Image
+}
+
+func (loader *textureLoader) load(textureMap *TextureMap, colorSpace texture.ColorSpace) (texture.Texture, error) {
+	if textureMap == nil {
+		return nil, nil
+	}
+
+	filename := loader.files.join(loader.dir, textureMap.Filename)
+	key := fmt.Sprint(filename, colorSpace, textureMap.Clamp)
+	if img, ok := loader.images[key]; ok {
+		return img, nil
+	}
+
+	f, err := loader.files.open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	decoded, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("obj: decoding %s: %w", filename, err)
+	}
+
+	img := texture.NewImage(decoded, colorSpace)
+	if textureMap.Clamp {
+		img.Wrap = texture.Clamp
+	}
+
+	loader.images[key] = img
+	return img, nil
+}
+
+// Material maps m onto our materials, loading its textures relative to dir.
+//   - Pr or Pm, the PBR extension, make a PBR material
+//   - illumination models with refraction (4, 6, 7 and 9) make a Dielectric
+//   - illumination models with ray traced reflection (3, 5 and 8) make a Metal tinted by Ks
+//   - anything else is Lambertian
+//
+// norm and bump maps wrap the result in a NormalMap or BumpMap.
+func (m *MTL) Material(dir string) (material.Material, error) {
+	return m.material(&textureLoader{files: osFiles{}, dir: dir, images: make(map[string]*texture.Image)})
+}
+
+func (m *MTL) material(loader *textureLoader) (material.Material, error) {
+	baseColorTexture, err := loader.load(m.MapKd, texture.SRGB)
+	if err != nil {
+		return nil, err
+	}
+
+	var result material.Material
+	switch {
+	case m.Pr >= 0 || m.Pm >= 0:
+		emissiveTexture, err := loader.load(m.MapKe, texture.SRGB)
+		if err != nil {
+			return nil, err
+		}
+		roughness := m.Pr
+		if roughness < 0 {
+			roughness = roughnessFromExponent(m.Ns)
+		}
+		result = &material.PBR{
+			BaseColor:        m.Kd,
+			BaseColorTexture: baseColorTexture,
+			EmissiveTexture:  emissiveTexture,
+			Metallic:         math.Max(m.Pm, 0),
+			Roughness:        roughness,
+			Emissive:         m.Ke,
+		}
+	case m.Illum == 4 || m.Illum == 6 || m.Illum == 7 || m.Illum == 9:
+		// exporters often leave Ni at 1, which would make the glass invisible
+		refractiveIndex := m.Ni
+		if refractiveIndex <= 1 {
+			refractiveIndex = 1.5
+		}
+		result = &material.Dielectric{RefractiveIndex: refractiveIndex}
+	case m.Illum == 3 || m.Illum == 5 || m.Illum == 8:
+		result = &material.Metal{Albedo: m.Ks, Roughness: roughnessFromExponent(m.Ns)}
+	default:
+		result = &material.Lambertian{Properties: material.MaterialProps{
+			Albedo:           m.Kd,
+			BaseColorTexture: baseColorTexture,
+			EmittanceColor:   m.Ke,
+		}}
+	}
+
+	// the maps read a zero scale as 1, but -bm 0 asks for a flat surface, so it is left unmapped
+	if m.NormMap != nil && m.NormMap.BumpScale != 0 {
+		normalMap, err := loader.load(m.NormMap, texture.Linear)
+		if err != nil {
+			return nil, err
+		}
+		result = &material.NormalMap{Material: result, Map: normalMap, Scale: m.NormMap.BumpScale}
+	} else if m.Bump != nil && m.Bump.BumpScale != 0 {
+		height, err := loader.load(m.Bump, texture.Linear)
+		if err != nil {
+			return nil, err
+		}
+		result = &material.BumpMap{Material: result, Height: height, Scale: m.Bump.BumpScale}
+	}
+
+	return result, nil
+}
+
+// LoadMTL reads an MTL file and maps its materials onto ours, see MTL.Material.
+func LoadMTL(filename string) (map[string]material.Material, error) {
+	return loadMTL(osFiles{}, filename)
+}
+
+func loadMTL(files files, filename string) (map[string]material.Material, error) {
+	f, err := files.open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	parsed, err := ParseMTL(f, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := &textureLoader{files: files, dir: files.dir(filename), images: make(map[string]*texture.Image)}
+	materials := make(map[string]material.Material, len(parsed))
+	for name, m := range parsed {
+		materials[name], err = m.material(loader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return materials, nil
+}
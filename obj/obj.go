@@ -0,0 +1,393 @@
+// Package obj loads Wavefront OBJ meshes and their MTL materials.
+// http://paulbourke.net/dataformats/obj/
+package obj
+
+import (
+	"bufio"
+	"fmt"
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/mesh"
+	"goraytracer/vec3"
+	"io"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// ParseError reports a malformed line.
+type ParseError struct {
+	Filename string
+	Line     int
+	Err      string
+}
+
+func (err *ParseError) Error() string {
+	return fmt.Sprintf("obj: %s:%d: %s", err.Filename, err.Line, err.Err)
+}
+
+// An Object holds the faces that share an object or group name and a material,
+// wherever they appear in the file.
+type Object struct {
+	Name     string
+	Material string // as named by usemtl, empty when there was none
+	Mesh     *geometry.TriangleMesh
+}
+
+type File struct {
+	Objects []Object
+
+	// the mtllib statements, relative to the OBJ file
+	MaterialLibraries []string
+}
+
+// a face corner's indices into the file's positions, uvs and normals, -1 when missing
+type corner struct {
+	position, uv, normal int
+}
+
+// collects the faces of one object, with vertices renumbered from zero
+type objectBuilder struct {
+	name     string
+	material string
+	smooth   bool
+
+	vertices   map[corner]uint32
+	corners    []corner
+	indices    []uint32
+	hasUVs     bool
+	hasNormals bool
+}
+
+type parser struct {
+	filename string
+	line     int
+
+	positions []vec3.Vec3
+	uvs       []geometry.TexCoord
+	normals   []vec3.Vec3
+
+	file File
+
+	// objects in the order they first appear, faces are collected into the object
+	// with the current name and material, which may have been started earlier
+	objects []*objectBuilder
+	byKey   map[[2]string]*objectBuilder
+	current *objectBuilder
+	name    string
+	smooth  bool
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &ParseError{Filename: p.filename, Line: p.line, Err: fmt.Sprintf(format, args...)}
+}
+
+// Parse reads an OBJ file. Faces with more than three corners are triangulated as fans,
+// which is exact for the convex polygons OBJ exporters write.
+// Statements that don't describe geometry, like lines and curves, are skipped.
+// filename is only used in error messages.
+func Parse(r io.Reader, filename string) (*File, error) {
+	p := parser{filename: filename, byKey: make(map[[2]string]*objectBuilder)}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		p.line++
+		if err := p.parseLine(scanner.Text()); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("obj: reading %s: %w", filename, err)
+	}
+
+	for _, object := range p.objects {
+		if err := p.finishObject(object); err != nil {
+			return nil, err
+		}
+	}
+
+	return &p.file, nil
+}
+
+// splits a line into its statement and arguments, dropping comments
+func fields(line string) (string, []string) {
+	if comment := strings.IndexByte(line, '#'); comment >= 0 {
+		line = line[:comment]
+	}
+	f := strings.Fields(line)
+	if len(f) == 0 {
+		return "", nil
+	}
+	return f[0], f[1:]
+}
+
+func (p *parser) parseFloats(args []string, min int, max int) ([]float64, error) {
+	if len(args) < min || len(args) > max {
+		if min == max {
+			return nil, p.errorf("expected %d numbers, got %d", min, len(args))
+		}
+		return nil, p.errorf("expected %d to %d numbers, got %d", min, max, len(args))
+	}
+
+	values := make([]float64, len(args))
+	for i, arg := range args {
+		value, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", arg)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func (p *parser) parseLine(line string) error {
+	statement, args := fields(line)
+
+	switch statement {
+	case "":
+		return nil
+	case "v":
+		// an optional w, or vertex colors, follow the position
+		values, err := p.parseFloats(args, 3, 7)
+		if err != nil {
+			return err
+		}
+		p.positions = append(p.positions, vec3.Vec3{X: values[0], Y: values[1], Z: values[2]})
+	case "vt":
+		values, err := p.parseFloats(args, 1, 3)
+		if err != nil {
+			return err
+		}
+		uv := geometry.TexCoord{U: values[0]}
+		if len(values) > 1 {
+			uv.V = values[1]
+		}
+		p.uvs = append(p.uvs, uv)
+	case "vn":
+		values, err := p.parseFloats(args, 3, 3)
+		if err != nil {
+			return err
+		}
+		p.normals = append(p.normals, vec3.Vec3{X: values[0], Y: values[1], Z: values[2]})
+	case "f":
+		return p.parseFace(args)
+	case "o", "g":
+		// a group with several names belongs to all of them, the first one names it here
+		name := ""
+		if len(args) > 0 {
+			name = args[0]
+		}
+		p.startObject(name, p.currentMaterial())
+	case "usemtl":
+		if len(args) != 1 {
+			return p.errorf("usemtl expects one material name, got %d", len(args))
+		}
+		p.startObject(p.name, args[0])
+	case "mtllib":
+		if len(args) == 0 {
+			return p.errorf("mtllib expects a file name")
+		}
+		p.file.MaterialLibraries = append(p.file.MaterialLibraries, args...)
+	case "s":
+		if len(args) != 1 {
+			return p.errorf("s expects a smoothing group or off, got %d arguments", len(args))
+		}
+		p.smooth = args[0] != "off" && args[0] != "0"
+		if p.current != nil && p.smooth {
+			p.current.smooth = true
+		}
+	}
+
+	return nil
+}
+
+func (p *parser) currentMaterial() string {
+	if p.current == nil {
+		return ""
+	}
+	return p.current.material
+}
+
+// collects the following faces into the object with name and material
+func (p *parser) startObject(name string, material string) {
+	p.name = name
+
+	key := [2]string{name, material}
+	if object, ok := p.byKey[key]; ok {
+		p.current = object
+		object.smooth = object.smooth || p.smooth
+		return
+	}
+
+	p.current = &objectBuilder{name: name, material: material, smooth: p.smooth, vertices: make(map[corner]uint32)}
+	p.objects = append(p.objects, p.current)
+	p.byKey[key] = p.current
+}
+
+// resolves a 1 based, or negative and relative, OBJ index into a slice of length count
+func (p *parser) resolveIndex(field string, kind string, count int) (int, error) {
+	index, err := strconv.Atoi(field)
+	if err != nil {
+		return 0, p.errorf("invalid %s index %q", kind, field)
+	}
+
+	resolved := index - 1
+	if index < 0 {
+		resolved = count + index
+	}
+	if index == 0 || resolved < 0 || resolved >= count {
+		return 0, p.errorf("%s index %d out of range, %d defined so far", kind, index, count)
+	}
+	return resolved, nil
+}
+
+// parses v, v/vt, v//vn or v/vt/vn
+func (p *parser) parseCorner(field string) (corner, error) {
+	c := corner{uv: -1, normal: -1}
+	parts := strings.Split(field, "/")
+	if len(parts) > 3 {
+		return c, p.errorf("invalid face corner %q", field)
+	}
+
+	var err error
+	if c.position, err = p.resolveIndex(parts[0], "vertex", len(p.positions)); err != nil {
+		return c, err
+	}
+	if len(parts) > 1 && parts[1] != "" {
+		if c.uv, err = p.resolveIndex(parts[1], "texture coordinate", len(p.uvs)); err != nil {
+			return c, err
+		}
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		if c.normal, err = p.resolveIndex(parts[2], "normal", len(p.normals)); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+func (p *parser) parseFace(args []string) error {
+	if len(args) < 3 {
+		return p.errorf("a face needs at least 3 vertices, got %d", len(args))
+	}
+
+	if p.current == nil {
+		p.startObject(p.name, "")
+	}
+	object := p.current
+
+	indices := make([]uint32, len(args))
+	for i, arg := range args {
+		c, err := p.parseCorner(arg)
+		if err != nil {
+			return err
+		}
+
+		index, ok := object.vertices[c]
+		if !ok {
+			index = uint32(len(object.corners))
+			object.vertices[c] = index
+			object.corners = append(object.corners, c)
+		}
+		indices[i] = index
+
+		object.hasUVs = object.hasUVs || c.uv >= 0
+		object.hasNormals = object.hasNormals || c.normal >= 0
+	}
+
+	for i := 1; i+1 < len(indices); i++ {
+		object.indices = append(object.indices, indices[0], indices[i], indices[i+1])
+	}
+	return nil
+}
+
+// builds the mesh of an object, objects without faces are dropped
+func (p *parser) finishObject(object *objectBuilder) error {
+	if len(object.indices) == 0 {
+		return nil
+	}
+
+	positions := make([]vec3.Vec3, len(object.corners))
+	for i, c := range object.corners {
+		positions[i] = p.positions[c.position]
+	}
+
+	triangleMesh, err := geometry.NewTriangleMesh(uint32(len(p.file.Objects)), positions, object.indices)
+	if err != nil {
+		return p.errorf("%v", err)
+	}
+
+	// corners without an attribute get a zero value, which triangles ignore
+	if object.hasUVs {
+		triangleMesh.UVs = make([]geometry.TexCoord, len(object.corners))
+		for i, c := range object.corners {
+			if c.uv >= 0 {
+				triangleMesh.UVs[i] = p.uvs[c.uv]
+			}
+		}
+	}
+	if object.hasNormals {
+		triangleMesh.Normals = make([]vec3.Vec3, len(object.corners))
+		for i, c := range object.corners {
+			if c.normal >= 0 {
+				triangleMesh.Normals[i] = p.normals[c.normal].Normalized()
+			}
+		}
+	} else if object.smooth {
+		// corners are split by their indices, so only positions shared by faces are smoothed
+		triangleMesh.ComputeNormals(geometry.AngleWeighted)
+	}
+
+	p.file.Objects = append(p.file.Objects, Object{Name: object.name, Material: object.material, Mesh: triangleMesh})
+	return nil
+}
+
+// Load reads an OBJ file along with the material libraries and textures it references,
+// relative to its directory. Objects get the material they name, or DefaultMaterial
+// when they name none or one no library defines.
+func Load(filename string) ([]mesh.Mesh, error) {
+	return load(osFiles{}, filename)
+}
+
+// LoadFS is Load for a file of fsys, like an embedded file system.
+func LoadFS(fsys fs.FS, name string) ([]mesh.Mesh, error) {
+	return load(fsFiles{fsys: fsys}, name)
+}
+
+func load(files files, filename string) ([]mesh.Mesh, error) {
+	f, err := files.open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	file, err := Parse(f, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := files.dir(filename)
+	materials := make(map[string]material.Material)
+	for _, library := range file.MaterialLibraries {
+		loaded, err := loadMTL(files, files.join(dir, library))
+		if err != nil {
+			return nil, err
+		}
+		for name, m := range loaded {
+			materials[name] = m
+		}
+	}
+
+	meshes := make([]mesh.Mesh, len(file.Objects))
+	for i, object := range file.Objects {
+		m, ok := materials[object.Material]
+		if !ok {
+			m = DefaultMaterial()
+		}
+		meshes[i] = mesh.Mesh{Geometry: object.Mesh, Material: m}
+	}
+	return meshes, nil
+}
@@ -0,0 +1,444 @@
+package obj_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/obj"
+	"goraytracer/ray"
+	"goraytracer/vec3"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func parse(t *testing.T, source string) *obj.File {
+	t.Helper()
+	file, err := obj.Parse(strings.NewReader(source), "test.obj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func nearlyEqual(a vec3.Vec3, b vec3.Vec3) bool {
+	return vec3.Sub(a, b).Length() < 1e-9
+}
+
+func TestParse_TriangulatesPolygons(t *testing.T) {
+	tests := []struct {
+		name          string
+		face          string
+		wantTriangles int
+	}{
+		{name: "triangle", face: "f 1 2 3", wantTriangles: 1},
+		{name: "quad", face: "f 1 2 3 4", wantTriangles: 2},
+		{name: "hexagon", face: "f 1 2 3 4 5 6", wantTriangles: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var source strings.Builder
+			for i := 0; i < 6; i++ {
+				angle := float64(i) * math.Pi / 3
+				fmt.Fprintf(&source, "v %v %v 0\n", math.Cos(angle), math.Sin(angle))
+			}
+			source.WriteString(tt.face + "\n")
+
+			file := parse(t, source.String())
+			if len(file.Objects) != 1 || file.Objects[0].Mesh.TriangleCount() != tt.wantTriangles {
+				t.Fatalf("got %d objects, want one with %d triangles", len(file.Objects), tt.wantTriangles)
+			}
+
+			// a fan covers the whole convex polygon
+			r := ray.New(vec3.Vec3{X: -.5, Y: -.1, Z: 1}, vec3.Vec3{Z: -1})
+			want := tt.wantTriangles == 4
+			if got := file.Objects[0].Mesh.Hit(r, 0, 10).Hit; got != want {
+				t.Errorf("Hit() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParse_FaceFormats(t *testing.T) {
+	const vertices = `
+v 0 0 0
+v 1 0 0
+v 0 1 0
+vt 0 0
+vt 1 0
+vt 0 1
+vn 0 0 1
+`
+	tests := []struct {
+		name        string
+		face        string
+		wantUVs     bool
+		wantNormals bool
+	}{
+		{name: "positions", face: "f 1 2 3"},
+		{name: "uvs", face: "f 1/1 2/2 3/3", wantUVs: true},
+		{name: "normals", face: "f 1//1 2//1 3//1", wantNormals: true},
+		{name: "uvs and normals", face: "f 1/1/1 2/2/1 3/3/1", wantUVs: true, wantNormals: true},
+		{name: "relative", face: "f -3/-3/-1 -2/-2/-1 -1/-1/-1", wantUVs: true, wantNormals: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mesh := parse(t, vertices+tt.face).Objects[0].Mesh
+
+			if (mesh.UVs != nil) != tt.wantUVs || (mesh.Normals != nil) != tt.wantNormals {
+				t.Fatalf("UVs = %v, Normals = %v", mesh.UVs, mesh.Normals)
+			}
+			if mesh.Positions[1] != (vec3.Vec3{X: 1}) {
+				t.Errorf("Positions = %v", mesh.Positions)
+			}
+
+			hit := mesh.Hit(ray.New(vec3.Vec3{X: .25, Y: .5, Z: 1}, vec3.Vec3{Z: -1}), 0, 10)
+			if !hit.Hit || math.Abs(hit.U-.25) > 1e-9 || math.Abs(hit.V-.5) > 1e-9 || !nearlyEqual(hit.Normal, vec3.Vec3{Z: 1}) {
+				t.Errorf("Hit() = %+v", hit)
+			}
+		})
+	}
+}
+
+func TestParse_SharesVertices(t *testing.T) {
+	file := parse(t, `
+v 0 0 0
+v 1 0 0
+v 1 1 0
+v 0 1 0
+vt 0 0
+vt 1 1
+f 1/1 2/1 3/1
+f 1/1 3/1 4/1
+f 1/2 3/2 4/2
+`)
+	// the first two faces share two vertices, the third one has different UVs
+	if got := len(file.Objects[0].Mesh.Positions); got != 7 {
+		t.Errorf("got %d vertices, want 7", got)
+	}
+}
+
+func TestParse_GroupsAndMaterials(t *testing.T) {
+	file := parse(t, `
+mtllib scene.mtl other.mtl
+v 0 0 0
+v 1 0 0
+v 0 1 0
+f 1 2 3
+o box
+usemtl red
+f 1 2 3
+usemtl blue
+f 1 2 3
+f 1 2 3
+g lid
+f 1 2 3
+g empty
+o box
+usemtl red
+f 1 2 3
+f 1 2 3
+`)
+	want := []struct {
+		name, material string
+		triangles      int
+	}{
+		{"", "", 1},
+		{"box", "red", 3},
+		{"box", "blue", 2},
+		{"lid", "blue", 1},
+	}
+
+	if len(file.Objects) != len(want) {
+		t.Fatalf("got %d objects, want %d", len(file.Objects), len(want))
+	}
+	for i, w := range want {
+		object := file.Objects[i]
+		if object.Name != w.name || object.Material != w.material || object.Mesh.TriangleCount() != w.triangles {
+			t.Errorf("object %d = %s %s with %d triangles, want %+v", i, object.Name, object.Material, object.Mesh.TriangleCount(), w)
+		}
+	}
+	if len(file.MaterialLibraries) != 2 || file.MaterialLibraries[1] != "other.mtl" {
+		t.Errorf("MaterialLibraries = %v", file.MaterialLibraries)
+	}
+}
+
+func TestParse_SmoothingComputesNormals(t *testing.T) {
+	const tent = `
+v -1 0 0
+v 0 1 0
+v 0 0 -1
+v 1 0 0
+f 1 2 3
+f 2 4 3
+`
+	if mesh := parse(t, tent).Objects[0].Mesh; mesh.Normals != nil {
+		t.Error("faceted mesh has normals")
+	}
+
+	mesh := parse(t, "s 1\n"+tent).Objects[0].Mesh
+	if mesh.Normals == nil {
+		t.Fatal("smooth mesh has no normals")
+	}
+	// the shared ridge vertex averages both faces
+	if mesh.Normals[1].X != 0 || mesh.Normals[1].Y <= 0 {
+		t.Errorf("ridge normal = %v", mesh.Normals[1])
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		wantLine int
+		wantErr  string
+	}{
+		{name: "bad number", source: "v 1 2 3\nv 1 x 3", wantLine: 2, wantErr: `invalid number "x"`},
+		{name: "short vertex", source: "v 1 2", wantLine: 1, wantErr: "expected 3 to 7 numbers, got 2"},
+		{name: "short face", source: "v 1 2 3\nv 1 2 3\n\nf 1 2", wantLine: 4, wantErr: "at least 3 vertices"},
+		{name: "index out of range", source: "v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 4", wantLine: 4, wantErr: "vertex index 4 out of range"},
+		{name: "zero index", source: "v 0 0 0\nf 0 1 1", wantLine: 2, wantErr: "vertex index 0 out of range"},
+		{name: "missing uv", source: "v 0 0 0\nf 1/1 1/1 1/1", wantLine: 2, wantErr: "texture coordinate index 1 out of range"},
+		{name: "bad corner", source: "v 0 0 0\nf 1/1/1/1 1 1", wantLine: 2, wantErr: `invalid face corner "1/1/1/1"`},
+		{name: "usemtl without a name", source: "# comment\nusemtl", wantLine: 2, wantErr: "usemtl expects one material name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := obj.Parse(strings.NewReader(tt.source), "broken.obj")
+
+			var parseError *obj.ParseError
+			if !errors.As(err, &parseError) {
+				t.Fatalf("Parse() error = %v, want a ParseError", err)
+			}
+			if parseError.Line != tt.wantLine || !strings.Contains(err.Error(), tt.wantErr) ||
+				!strings.HasPrefix(err.Error(), fmt.Sprintf("obj: broken.obj:%d: ", tt.wantLine)) {
+				t.Errorf("Parse() error = %q, want line %d and %q", err, tt.wantLine, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseMTL(t *testing.T) {
+	materials, err := obj.ParseMTL(strings.NewReader(`
+newmtl glass
+Kd 1 1 1
+Ni 1.45
+d 0.2 # almost clear
+illum 7
+
+newmtl textured
+Kd 0.5
+map_Kd -clamp on -s 2 2 textures/wood grain.png
+bump -bm 0.25 height.png
+`), "test.mtl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	glass := materials["glass"]
+	if glass == nil || glass.Ni != 1.45 || glass.D != .2 || glass.Illum != 7 || glass.Pr >= 0 {
+		t.Errorf("glass = %+v", glass)
+	}
+
+	textured := materials["textured"]
+	if textured == nil || textured.Kd != (vec3.Vec3{X: .5, Y: .5, Z: .5}) {
+		t.Fatalf("textured = %+v", textured)
+	}
+	if *textured.MapKd != (obj.TextureMap{Filename: "textures/wood grain.png", BumpScale: 1, Clamp: true}) {
+		t.Errorf("MapKd = %+v", textured.MapKd)
+	}
+	if *textured.Bump != (obj.TextureMap{Filename: "height.png", BumpScale: .25}) {
+		t.Errorf("Bump = %+v", textured.Bump)
+	}
+}
+
+func TestParseMTL_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		wantLine int
+	}{
+		{name: "before newmtl", source: "Kd 1 1 1", wantLine: 1},
+		{name: "two color components", source: "newmtl a\nKd 1 1", wantLine: 2},
+		{name: "bad exponent", source: "newmtl a\n\nNs high", wantLine: 3},
+		{name: "unknown texture option", source: "newmtl a\nmap_Kd -sharpen 2 a.png", wantLine: 2},
+		{name: "texture without a file", source: "newmtl a\nmap_Kd -bm 2", wantLine: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := obj.ParseMTL(strings.NewReader(tt.source), "broken.mtl")
+
+			var parseError *obj.ParseError
+			if !errors.As(err, &parseError) || parseError.Line != tt.wantLine {
+				t.Errorf("ParseMTL() error = %v, want a ParseError on line %d", err, tt.wantLine)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, filename string, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filename, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writePNG(t *testing.T, filename string) {
+	t.Helper()
+	f, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	writePNGTo(t, f)
+}
+
+func writePNGTo(t *testing.T, w io.Writer) {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, color.NRGBA{R: 128, G: 128, B: 255, A: 255})
+	if err := png.Encode(w, img); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, filepath.Join(dir, "normal.png"))
+	writeFile(t, filepath.Join(dir, "scene.mtl"), `
+newmtl matte
+Kd 0.2 0.4 0.6
+
+newmtl lamp
+Kd 0 0 0
+Ke 4 4 4
+
+newmtl chrome
+Ks 0.9 0.9 0.9
+Ns 1000
+illum 3
+
+newmtl glass
+Ni 1.5
+illum 4
+
+newmtl rough_gold
+Kd 1 0.8 0.3
+Pm 1
+Pr 0.4
+
+newmtl tiles
+Kd 1 1 1
+norm normal.png
+
+newmtl flat
+Kd 1 1 1
+bump -bm 0 normal.png
+`)
+	writeFile(t, filepath.Join(dir, "scene.obj"), `
+mtllib scene.mtl
+v 0 0 0
+v 1 0 0
+v 0 1 0
+usemtl matte
+f 1 2 3
+usemtl lamp
+f 1 2 3
+usemtl chrome
+f 1 2 3
+usemtl glass
+f 1 2 3
+usemtl rough_gold
+f 1 2 3
+usemtl tiles
+f 1 2 3
+usemtl flat
+f 1 2 3
+usemtl undefined
+f 1 2 3
+`)
+
+	meshes, err := obj.Load(filepath.Join(dir, "scene.obj"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(meshes) != 8 {
+		t.Fatalf("got %d meshes, want 8", len(meshes))
+	}
+
+	if matte, ok := meshes[0].Material.(*material.Lambertian); !ok || matte.Properties.Albedo != (vec3.Vec3{X: .2, Y: .4, Z: .6}) {
+		t.Errorf("matte = %#v", meshes[0].Material)
+	}
+	if lamp := meshes[1].Material.Emitted(geometry.HitRecord{}); lamp != (vec3.Vec3{X: 4, Y: 4, Z: 4}) {
+		t.Errorf("lamp emits %v", lamp)
+	}
+	if chrome, ok := meshes[2].Material.(*material.Metal); !ok || chrome.Albedo.X != .9 || chrome.Roughness > .05 {
+		t.Errorf("chrome = %#v", meshes[2].Material)
+	}
+	if glass, ok := meshes[3].Material.(*material.Dielectric); !ok || glass.RefractiveIndex != 1.5 {
+		t.Errorf("glass = %#v", meshes[3].Material)
+	}
+	if gold, ok := meshes[4].Material.(*material.PBR); !ok || gold.Metallic != 1 || gold.Roughness != .4 {
+		t.Errorf("rough_gold = %#v", meshes[4].Material)
+	}
+	if tiles, ok := meshes[5].Material.(*material.NormalMap); !ok || tiles.Map == nil {
+		t.Errorf("tiles = %#v", meshes[5].Material)
+	} else if _, ok := tiles.Material.(*material.Lambertian); !ok {
+		t.Errorf("tiles wraps %#v", tiles.Material)
+	}
+	if _, ok := meshes[6].Material.(*material.Lambertian); !ok {
+		t.Errorf("flat = %#v, want no bump map at -bm 0", meshes[6].Material)
+	}
+	if undefined, ok := meshes[7].Material.(*material.Lambertian); !ok || undefined.Properties.Albedo.X != .8 {
+		t.Errorf("undefined = %#v", meshes[7].Material)
+	}
+
+	for i, m := range meshes {
+		if m.Geometry.GetId() != uint32(i) {
+			t.Errorf("mesh %d has id %d", i, m.Geometry.GetId())
+		}
+	}
+}
+
+func TestLoad_MissingTexture(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.mtl"), "newmtl a\nmap_Kd missing.png\n")
+	writeFile(t, filepath.Join(dir, "a.obj"), "mtllib a.mtl\nv 0 0 0\nv 1 0 0\nv 0 1 0\nusemtl a\nf 1 2 3\n")
+
+	if _, err := obj.Load(filepath.Join(dir, "a.obj")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Load() error = %v, want a missing file", err)
+	}
+}
+
+func TestLoadFS(t *testing.T) {
+	var paint bytes.Buffer
+	writePNGTo(t, &paint)
+	fsys := fstest.MapFS{
+		"models/box.obj":           {Data: []byte("mtllib materials/box.mtl\nv 0 0 0\nv 1 0 0\nv 0 1 0\nusemtl painted\nf 1 2 3\n")},
+		"models/materials/box.mtl": {Data: []byte("newmtl painted\nmap_Kd ../../textures/paint.png\n")},
+		"textures/paint.png":       {Data: paint.Bytes()},
+	}
+
+	meshes, err := obj.LoadFS(fsys, "models/box.obj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if painted, ok := meshes[0].Material.(*material.Lambertian); !ok || painted.Properties.BaseColorTexture == nil {
+		t.Errorf("painted = %#v", meshes[0].Material)
+	}
+
+	delete(fsys, "textures/paint.png")
+	if _, err := obj.LoadFS(fsys, "models/box.obj"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("LoadFS() error = %v, want a missing file", err)
+	}
+}
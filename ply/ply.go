@@ -0,0 +1,501 @@
+// Package ply reads Stanford PLY meshes, like the scans of the Stanford 3D Scanning Repository.
+// http://paulbourke.net/dataformats/ply/
+package ply
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"goraytracer/geometry"
+	"goraytracer/vec3"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type Format int
+
+const (
+	ASCII Format = iota
+	BinaryLittleEndian
+	BinaryBigEndian
+)
+
+var formats = map[string]Format{
+	"ascii":                ASCII,
+	"binary_little_endian": BinaryLittleEndian,
+	"binary_big_endian":    BinaryBigEndian,
+}
+
+type scalarType int
+
+const (
+	int8Type scalarType = iota
+	uint8Type
+	int16Type
+	uint16Type
+	int32Type
+	uint32Type
+	float32Type
+	float64Type
+)
+
+// both the original names and the sized ones later files use
+var scalarTypes = map[string]scalarType{
+	"char": int8Type, "int8": int8Type,
+	"uchar": uint8Type, "uint8": uint8Type,
+	"short": int16Type, "int16": int16Type,
+	"ushort": uint16Type, "uint16": uint16Type,
+	"int": int32Type, "int32": int32Type,
+	"uint": uint32Type, "uint32": uint32Type,
+	"float": float32Type, "float32": float32Type,
+	"double": float64Type, "float64": float64Type,
+}
+
+var scalarSizes = [...]int{1, 1, 2, 2, 4, 4, 4, 8}
+
+// the value integer colors are scaled down from, 0 for floating point types
+var scalarMax = [...]float64{math.MaxInt8, math.MaxUint8, math.MaxInt16, math.MaxUint16, math.MaxInt32, math.MaxUint32, 0, 0}
+
+type property struct {
+	name      string
+	valueType scalarType
+	isList    bool
+	countType scalarType
+}
+
+type element struct {
+	name       string
+	count      int
+	properties []property
+}
+
+// returns the index of the first property with one of names, or -1
+func (e *element) find(names ...string) int {
+	for _, name := range names {
+		for i, p := range e.properties {
+			if p.name == name && !p.isList {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+type header struct {
+	format   Format
+	elements []element
+}
+
+// readHeader reads the header up to and including end_header, returning the number of lines read
+func readHeader(r *bufio.Reader, filename string) (header, int, error) {
+	var h header
+	line := 0
+	formatSeen := false
+
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("ply: %s:%d: %s", filename, line, fmt.Sprintf(format, args...))
+	}
+
+	for {
+		text, err := r.ReadString('\n')
+		if err != nil && (err != io.EOF || text == "") {
+			if err == io.EOF {
+				return h, line, fmt.Errorf("ply: %s: the header has no end_header", filename)
+			}
+			return h, line, fmt.Errorf("ply: reading %s: %w", filename, err)
+		}
+		line++
+		f := strings.Fields(text)
+
+		if line == 1 {
+			if len(f) != 1 || f[0] != "ply" {
+				return h, line, fmt.Errorf("ply: %s is not a PLY file", filename)
+			}
+			continue
+		}
+		if len(f) == 0 {
+			continue
+		}
+
+		switch f[0] {
+		case "format":
+			if len(f) != 3 {
+				return h, line, errorf("format expects a format and a version")
+			}
+			format, ok := formats[f[1]]
+			if !ok {
+				return h, line, errorf("unknown format %q", f[1])
+			}
+			if f[2] != "1.0" {
+				return h, line, errorf("unsupported version %s", f[2])
+			}
+			h.format = format
+			formatSeen = true
+		case "comment", "obj_info":
+		case "element":
+			if len(f) != 3 {
+				return h, line, errorf("element expects a name and a count")
+			}
+			count, err := strconv.Atoi(f[2])
+			if err != nil || count < 0 {
+				return h, line, errorf("invalid element count %q", f[2])
+			}
+			h.elements = append(h.elements, element{name: f[1], count: count})
+		case "property":
+			if len(h.elements) == 0 {
+				return h, line, errorf("property before any element")
+			}
+			p, err := parseProperty(f[1:])
+			if err != nil {
+				return h, line, errorf("%v", err)
+			}
+			e := &h.elements[len(h.elements)-1]
+			e.properties = append(e.properties, p)
+		case "end_header":
+			if !formatSeen {
+				return h, line, errorf("the header has no format")
+			}
+			return h, line, nil
+		default:
+			return h, line, errorf("unknown header keyword %q", f[0])
+		}
+	}
+}
+
+// parses "type name" or "list countType valueType name"
+func parseProperty(f []string) (property, error) {
+	if len(f) == 4 && f[0] == "list" {
+		countType, ok := scalarTypes[f[1]]
+		if !ok {
+			return property{}, fmt.Errorf("unknown type %q", f[1])
+		}
+		if countType == float32Type || countType == float64Type {
+			return property{}, fmt.Errorf("list count type %s is not an integer type", f[1])
+		}
+		valueType, ok := scalarTypes[f[2]]
+		if !ok {
+			return property{}, fmt.Errorf("unknown type %q", f[2])
+		}
+		return property{name: f[3], valueType: valueType, isList: true, countType: countType}, nil
+	}
+
+	if len(f) != 2 {
+		return property{}, errors.New("property expects a type and a name")
+	}
+	valueType, ok := scalarTypes[f[0]]
+	if !ok {
+		return property{}, fmt.Errorf("unknown type %q", f[0])
+	}
+	return property{name: f[1], valueType: valueType}, nil
+}
+
+// valueReader reads the values of one element at a time
+type valueReader interface {
+	begin() error
+	read(valueType scalarType) (float64, error)
+	end() error
+}
+
+// reads one element per line
+type asciiReader struct {
+	r      *bufio.Reader
+	line   int
+	fields []string
+}
+
+func (reader *asciiReader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", reader.line, fmt.Sprintf(format, args...))
+}
+
+func (reader *asciiReader) begin() error {
+	for {
+		text, err := reader.r.ReadString('\n')
+		if err != nil && (err != io.EOF || text == "") {
+			if err == io.EOF {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		reader.line++
+
+		reader.fields = strings.Fields(text)
+		if len(reader.fields) > 0 {
+			return nil
+		}
+	}
+}
+
+func (reader *asciiReader) read(valueType scalarType) (float64, error) {
+	if len(reader.fields) == 0 {
+		return 0, reader.errorf("too few values")
+	}
+	field := reader.fields[0]
+	reader.fields = reader.fields[1:]
+
+	if valueType == float32Type || valueType == float64Type {
+		value, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return 0, reader.errorf("invalid number %q", field)
+		}
+		return value, nil
+	}
+
+	value, err := strconv.ParseInt(field, 10, 64)
+	if err != nil {
+		return 0, reader.errorf("invalid integer %q", field)
+	}
+	return float64(value), nil
+}
+
+func (reader *asciiReader) end() error {
+	if len(reader.fields) > 0 {
+		return reader.errorf("unexpected values %v", reader.fields)
+	}
+	return nil
+}
+
+type binaryReader struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+	buf   [8]byte
+}
+
+func (reader *binaryReader) begin() error {
+	return nil
+}
+
+func (reader *binaryReader) read(valueType scalarType) (float64, error) {
+	buf := reader.buf[:scalarSizes[valueType]]
+	if _, err := io.ReadFull(reader.r, buf); err != nil {
+		if err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	switch valueType {
+	case int8Type:
+		return float64(int8(buf[0])), nil
+	case uint8Type:
+		return float64(buf[0]), nil
+	case int16Type:
+		return float64(int16(reader.order.Uint16(buf))), nil
+	case uint16Type:
+		return float64(reader.order.Uint16(buf)), nil
+	case int32Type:
+		return float64(int32(reader.order.Uint32(buf))), nil
+	case uint32Type:
+		return float64(reader.order.Uint32(buf)), nil
+	case float32Type:
+		return float64(math.Float32frombits(reader.order.Uint32(buf))), nil
+	default:
+		return math.Float64frombits(reader.order.Uint64(buf)), nil
+	}
+}
+
+func (reader *binaryReader) end() error {
+	return nil
+}
+
+// reads the values of one element, skipping list properties into lists
+func readElement(reader valueReader, e *element, values []float64, lists [][]float64) error {
+	if err := reader.begin(); err != nil {
+		return err
+	}
+
+	for i, p := range e.properties {
+		if !p.isList {
+			value, err := reader.read(p.valueType)
+			if err != nil {
+				return err
+			}
+			values[i] = value
+			continue
+		}
+
+		count, err := reader.read(p.countType)
+		if err != nil {
+			return err
+		}
+		if count < 0 {
+			return fmt.Errorf("negative list length %v", count)
+		}
+
+		lists[i] = lists[i][:0]
+		for j := 0; j < int(count); j++ {
+			value, err := reader.read(p.valueType)
+			if err != nil {
+				return err
+			}
+			lists[i] = append(lists[i], value)
+		}
+	}
+
+	return reader.end()
+}
+
+// limits how much a header's element counts can make us allocate up front
+const maxPreallocated = 1 << 20
+
+func preallocated(count int) int {
+	if count > maxPreallocated {
+		return maxPreallocated
+	}
+	return count
+}
+
+// Read reads a PLY mesh. The vertex element needs x, y and z, and may have normals
+// (nx, ny, nz), colors (red, green, blue) and texture coordinates (u and v, s and t,
+// or texture_u and texture_v). Integer colors are scaled to [0, 1].
+// Faces of any size are triangulated as fans. Other elements and properties are skipped.
+// Scans usually come without normals: call ComputeNormals on the mesh to shade it smoothly.
+// filename is only used in error messages.
+func Read(id uint32, r io.Reader, filename string) (*geometry.TriangleMesh, error) {
+	buffered := bufio.NewReader(r)
+
+	h, headerLines, err := readHeader(buffered, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader valueReader
+	switch h.format {
+	case ASCII:
+		reader = &asciiReader{r: buffered, line: headerLines}
+	case BinaryLittleEndian:
+		reader = &binaryReader{r: buffered, order: binary.LittleEndian}
+	default:
+		reader = &binaryReader{r: buffered, order: binary.BigEndian}
+	}
+
+	var positions, normals, colors []vec3.Vec3
+	var uvs []geometry.TexCoord
+	var indices []uint32
+	vertexSeen := false
+
+	for e := range h.elements {
+		e := &h.elements[e]
+		values := make([]float64, len(e.properties))
+		lists := make([][]float64, len(e.properties))
+
+		errorf := func(i int, err error) error {
+			if err == io.ErrUnexpectedEOF {
+				err = errors.New("unexpected end of file")
+			}
+			return fmt.Errorf("ply: %s: %s %d: %w", filename, e.name, i, err)
+		}
+
+		switch e.name {
+		case "vertex":
+			vertexSeen = true
+			x, y, z := e.find("x"), e.find("y"), e.find("z")
+			if x < 0 || y < 0 || z < 0 {
+				return nil, fmt.Errorf("ply: %s: vertices need x, y and z properties", filename)
+			}
+			nx, ny, nz := e.find("nx"), e.find("ny"), e.find("nz")
+			hasNormals := nx >= 0 && ny >= 0 && nz >= 0
+			red, green, blue := e.find("red", "diffuse_red"), e.find("green", "diffuse_green"), e.find("blue", "diffuse_blue")
+			hasColors := red >= 0 && green >= 0 && blue >= 0
+			u, v := e.find("u", "s", "texture_u", "texture_s"), e.find("v", "t", "texture_v", "texture_t")
+			hasUVs := u >= 0 && v >= 0
+
+			positions = make([]vec3.Vec3, 0, preallocated(e.count))
+			for i := 0; i < e.count; i++ {
+				if err := readElement(reader, e, values, lists); err != nil {
+					return nil, errorf(i, err)
+				}
+
+				positions = append(positions, vec3.Vec3{X: values[x], Y: values[y], Z: values[z]})
+				if hasNormals {
+					normals = append(normals, vec3.Vec3{X: values[nx], Y: values[ny], Z: values[nz]}.Normalized())
+				}
+				if hasColors {
+					colors = append(colors, vec3.Vec3{
+						X: colorValue(values[red], e.properties[red].valueType),
+						Y: colorValue(values[green], e.properties[green].valueType),
+						Z: colorValue(values[blue], e.properties[blue].valueType),
+					})
+				}
+				if hasUVs {
+					uvs = append(uvs, geometry.TexCoord{U: values[u], V: values[v]})
+				}
+			}
+		case "face":
+			list := -1
+			for i, p := range e.properties {
+				if p.isList && (p.name == "vertex_indices" || p.name == "vertex_index") {
+					list = i
+				}
+			}
+			if list < 0 {
+				return nil, fmt.Errorf("ply: %s: faces need a vertex_indices list", filename)
+			}
+
+			indices = make([]uint32, 0, preallocated(3*e.count))
+			for i := 0; i < e.count; i++ {
+				if err := readElement(reader, e, values, lists); err != nil {
+					return nil, errorf(i, err)
+				}
+
+				face := lists[list]
+				if len(face) < 3 {
+					return nil, errorf(i, fmt.Errorf("a face needs at least 3 vertices, got %d", len(face)))
+				}
+				for _, index := range face {
+					if index < 0 || index > math.MaxUint32 {
+						return nil, errorf(i, fmt.Errorf("invalid vertex index %v", index))
+					}
+				}
+				for j := 1; j+1 < len(face); j++ {
+					indices = append(indices, uint32(face[0]), uint32(face[j]), uint32(face[j+1]))
+				}
+			}
+		default:
+			for i := 0; i < e.count; i++ {
+				if err := readElement(reader, e, values, lists); err != nil {
+					return nil, errorf(i, err)
+				}
+			}
+		}
+	}
+
+	if !vertexSeen {
+		return nil, fmt.Errorf("ply: %s has no vertex element", filename)
+	}
+
+	mesh, err := geometry.NewTriangleMesh(id, positions, indices)
+	if err != nil {
+		return nil, fmt.Errorf("ply: %s: %w", filename, err)
+	}
+	mesh.Normals = normals
+	mesh.Colors = colors
+	mesh.UVs = uvs
+	if err := mesh.Validate(); err != nil {
+		return nil, fmt.Errorf("ply: %s: %w", filename, err)
+	}
+
+	return mesh, nil
+}
+
+func colorValue(value float64, valueType scalarType) float64 {
+	if scalarMax[valueType] == 0 {
+		return value
+	}
+	return value / scalarMax[valueType]
+}
+
+// Load reads a PLY file, see Read.
+func Load(id uint32, filename string) (*geometry.TriangleMesh, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return Read(id, f, filename)
+}
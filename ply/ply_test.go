@@ -0,0 +1,223 @@
+package ply_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"goraytracer/geometry"
+	"goraytracer/ply"
+	"goraytracer/vec3"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// a unit square as a quad and a triangle above it, with every vertex property we read
+const asciiSquare = `ply
+format ascii 1.0
+comment made by hand
+element vertex 5
+property float x
+property float y
+property float z
+property float nx
+property float ny
+property float nz
+property uchar red
+property uchar green
+property uchar blue
+property float u
+property float v
+element face 2
+property uchar intensity
+property list uchar int vertex_indices
+end_header
+0 0 0 0 0 2 255 0 0 0 0
+1 0 0 0 0 2 0 255 0 1 0
+1 1 0 0 0 2 0 0 255 1 1
+0 1 0 0 0 2 255 255 255 0 1
+
+0 0 1 0 0 1 0 0 0 .5 .5
+7 4 0 1 2 3
+7 3 0 1 4
+`
+
+func checkSquare(t *testing.T, mesh *geometry.TriangleMesh) {
+	t.Helper()
+
+	if len(mesh.Positions) != 5 || mesh.Positions[2] != (vec3.Vec3{X: 1, Y: 1}) {
+		t.Errorf("Positions = %v", mesh.Positions)
+	}
+	if mesh.TriangleCount() != 3 {
+		t.Errorf("TriangleCount() = %d, want 3", mesh.TriangleCount())
+	}
+	if want := []uint32{0, 1, 2, 0, 2, 3, 0, 1, 4}; !equalIndices(mesh.Indices, want) {
+		t.Errorf("Indices = %v, want %v", mesh.Indices, want)
+	}
+	if len(mesh.Normals) != 5 || mesh.Normals[0] != (vec3.Vec3{Z: 1}) {
+		t.Errorf("Normals = %v", mesh.Normals)
+	}
+	if len(mesh.Colors) != 5 || mesh.Colors[1] != (vec3.Vec3{Y: 1}) || mesh.Colors[3] != (vec3.Vec3{X: 1, Y: 1, Z: 1}) {
+		t.Errorf("Colors = %v", mesh.Colors)
+	}
+	if len(mesh.UVs) != 5 || mesh.UVs[4] != (geometry.TexCoord{U: .5, V: .5}) {
+		t.Errorf("UVs = %v", mesh.UVs)
+	}
+}
+
+func equalIndices(a []uint32, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRead_ASCII(t *testing.T) {
+	mesh, err := ply.Read(3, strings.NewReader(asciiSquare), "square.ply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSquare(t, mesh)
+	if mesh.GetId() != 3 {
+		t.Errorf("GetId() = %d", mesh.GetId())
+	}
+}
+
+// encodes the square of asciiSquare in a binary format
+func binarySquare(format string, order binary.ByteOrder) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(strings.Replace(asciiSquare[:strings.Index(asciiSquare, "end_header\n")+len("end_header\n")],
+		"format ascii 1.0", "format "+format+" 1.0", 1))
+
+	type vertex struct {
+		X, Y, Z, NX, NY, NZ float32
+		R, G, B             uint8
+		U, V                float32
+	}
+	vertices := []vertex{
+		{0, 0, 0, 0, 0, 2, 255, 0, 0, 0, 0},
+		{1, 0, 0, 0, 0, 2, 0, 255, 0, 1, 0},
+		{1, 1, 0, 0, 0, 2, 0, 0, 255, 1, 1},
+		{0, 1, 0, 0, 0, 2, 255, 255, 255, 0, 1},
+		{0, 0, 1, 0, 0, 1, 0, 0, 0, .5, .5},
+	}
+	for _, v := range vertices {
+		binary.Write(&buf, order, v)
+	}
+
+	binary.Write(&buf, order, []uint8{7, 4})
+	binary.Write(&buf, order, []int32{0, 1, 2, 3})
+	binary.Write(&buf, order, []uint8{7, 3})
+	binary.Write(&buf, order, []int32{0, 1, 4})
+	return buf.Bytes()
+}
+
+func TestRead_Binary(t *testing.T) {
+	tests := []struct {
+		format string
+		order  binary.ByteOrder
+	}{
+		{format: "binary_little_endian", order: binary.LittleEndian},
+		{format: "binary_big_endian", order: binary.BigEndian},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			mesh, err := ply.Read(0, bytes.NewReader(binarySquare(tt.format, tt.order)), "square.ply")
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkSquare(t, mesh)
+		})
+	}
+}
+
+func TestRead_SkipsOtherElements(t *testing.T) {
+	const source = `ply
+format binary_little_endian 1.0
+element camera 1
+property double view_px
+property list ushort short samples
+element vertex 3
+property double x
+property double y
+property double z
+element face 1
+property list uint uint vertex_index
+end_header
+`
+	var buf bytes.Buffer
+	buf.WriteString(source)
+	binary.Write(&buf, binary.LittleEndian, 1.5)
+	binary.Write(&buf, binary.LittleEndian, []uint16{2})
+	binary.Write(&buf, binary.LittleEndian, []int16{-1, 1})
+	binary.Write(&buf, binary.LittleEndian, []float64{0, 0, 0, 1, 0, 0, 0, 1, 0})
+	binary.Write(&buf, binary.LittleEndian, []uint32{3, 0, 1, 2})
+
+	mesh, err := ply.Read(0, &buf, "camera.ply")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mesh.TriangleCount() != 1 || mesh.Positions[2] != (vec3.Vec3{Y: 1}) || mesh.Normals != nil || mesh.Colors != nil {
+		t.Errorf("mesh = %+v", mesh)
+	}
+}
+
+func TestRead_Errors(t *testing.T) {
+	header := func(format string, faces string) string {
+		return "ply\nformat " + format + " 1.0\nelement vertex 3\nproperty float x\nproperty float y\nproperty float z\n" +
+			"element face " + faces + "\nproperty list uchar int vertex_indices\nend_header\n"
+	}
+	vertices := "0 0 0\n1 0 0\n0 1 0\n"
+
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "not ply", source: "obj\n", wantErr: "not a PLY file"},
+		{name: "unknown format", source: "ply\nformat binary_middle_endian 1.0\nend_header\n", wantErr: `bad.ply:2: unknown format "binary_middle_endian"`},
+		{name: "unknown type", source: "ply\nformat ascii 1.0\nelement vertex 1\nproperty real x\nend_header\n", wantErr: `bad.ply:4: unknown type "real"`},
+		{name: "property before element", source: "ply\nformat ascii 1.0\nproperty float x\n", wantErr: "bad.ply:3: property before any element"},
+		{name: "no end_header", source: "ply\nformat ascii 1.0\nelement vertex 0\n", wantErr: "no end_header"},
+		{name: "missing coordinate", source: "ply\nformat ascii 1.0\nelement vertex 1\nproperty float x\nproperty float y\nend_header\n0 0\n", wantErr: "need x, y and z"},
+		{name: "bad number", source: header("ascii", "0") + "0 0 0\n1 zero 0\n0 1 0\n", wantErr: `vertex 1: line 11: invalid number "zero"`},
+		{name: "extra values", source: header("ascii", "0") + "0 0 0 0\n", wantErr: "vertex 0: line 10: unexpected values [0]"},
+		{name: "truncated", source: header("ascii", "2") + vertices + "3 0 1 2\n", wantErr: "face 1: unexpected end of file"},
+		{name: "small face", source: header("ascii", "1") + vertices + "2 0 1\n", wantErr: "face 0: a face needs at least 3 vertices, got 2"},
+		{name: "negative index", source: header("ascii", "1") + vertices + "3 0 1 -2\n", wantErr: "face 0: invalid vertex index -2"},
+		{name: "index out of range", source: header("ascii", "1") + vertices + "3 0 1 3\n", wantErr: "vertex index 3 out of range"},
+		{name: "truncated binary", source: header("binary_little_endian", "0") + "\x00\x00", wantErr: "vertex 0: unexpected end of file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ply.Read(0, strings.NewReader(tt.source), "bad.ply")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.HasPrefix(err.Error(), "ply: ") {
+				t.Errorf("Read() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "square.ply")
+	if err := os.WriteFile(filename, binarySquare("binary_little_endian", binary.LittleEndian), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	mesh, err := ply.Load(0, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSquare(t, mesh)
+
+	mesh.ComputeNormals(geometry.AngleWeighted)
+	if math.Abs(mesh.Normals[2].Z-1) > 1e-9 {
+		t.Errorf("Normals[2] = %v", mesh.Normals[2])
+	}
+}
@@ -0,0 +1,179 @@
+package scenefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"goraytracer/vec3"
+	"math"
+)
+
+// fields reads the members of a JSON object. The first error sticks and later reads
+// return their fallbacks, so a whole object can be read before checking it.
+type fields struct {
+	d   *decoder
+	n   *node
+	obj *object
+	err error
+}
+
+// fields checks that n is an object with only the given keys
+func (d *decoder) fields(n *node, what string, keys ...string) *fields {
+	f := &fields{d: d, n: n, obj: &object{}}
+
+	obj, ok := n.value.(*object)
+	if !ok {
+		f.err = d.errorf(n, "%s must be an object, not %s", what, describe(n))
+		return f
+	}
+	f.obj = obj
+
+	for _, m := range obj.members {
+		known := false
+		for _, key := range keys {
+			known = known || m.key == key
+		}
+		if !known {
+			f.err = d.errorAt(m.keyOffset, "unknown field %q in %s", m.key, what)
+			return f
+		}
+	}
+	return f
+}
+
+// get returns the value of key, nil when it is missing or an error occurred
+func (f *fields) get(key string) *node {
+	if f.err != nil {
+		return nil
+	}
+	return f.obj.get(key)
+}
+
+func (f *fields) fail(err error) {
+	if f.err == nil {
+		f.err = err
+	}
+}
+
+// require fails unless every key is present
+func (f *fields) require(keys ...string) {
+	for _, key := range keys {
+		if f.err == nil && f.obj.get(key) == nil {
+			f.err = f.d.errorf(f.n, "missing field %q", key)
+		}
+	}
+}
+
+func (f *fields) number(key string, fallback float64) float64 {
+	n := f.get(key)
+	if n == nil {
+		return fallback
+	}
+	value, err := f.d.number(n, key)
+	if err != nil {
+		f.fail(err)
+		return fallback
+	}
+	return value
+}
+
+// positive reads a number that must be greater than zero
+func (f *fields) positive(key string, fallback float64) float64 {
+	value := f.number(key, fallback)
+	if f.get(key) != nil && value <= 0 {
+		f.fail(f.d.errorf(f.get(key), "%s must be positive", key))
+	}
+	return value
+}
+
+// integer reads a whole number greater than zero
+func (f *fields) integer(key string, fallback int) int {
+	value := f.positive(key, float64(fallback))
+	if f.get(key) != nil && (value != math.Trunc(value) || value > math.MaxInt32) {
+		f.fail(f.d.errorf(f.get(key), "%s must be a whole number", key))
+	}
+	return int(value)
+}
+
+func (f *fields) string(key string, fallback string) string {
+	n := f.get(key)
+	if n == nil {
+		return fallback
+	}
+	value, ok := n.value.(string)
+	if !ok {
+		f.fail(f.d.errorf(n, "%s must be a string, not %s", key, describe(n)))
+		return fallback
+	}
+	return value
+}
+
+// choice reads a string that must be one of choices, the first being the fallback
+func (f *fields) choice(key string, choices ...string) int {
+	value := f.string(key, choices[0])
+	if f.err != nil {
+		return 0
+	}
+	for i, choice := range choices {
+		if value == choice {
+			return i
+		}
+	}
+	f.fail(f.d.errorf(f.get(key), "unknown %s %q, want one of %q", key, value, choices))
+	return 0
+}
+
+func (f *fields) boolean(key string, fallback bool) bool {
+	n := f.get(key)
+	if n == nil {
+		return fallback
+	}
+	value, ok := n.value.(bool)
+	if !ok {
+		f.fail(f.d.errorf(n, "%s must be true or false, not %s", key, describe(n)))
+		return fallback
+	}
+	return value
+}
+
+func (f *fields) vec3(key string, fallback vec3.Vec3) vec3.Vec3 {
+	n := f.get(key)
+	if n == nil {
+		return fallback
+	}
+	value, err := f.d.vec3(n, key)
+	if err != nil {
+		f.fail(err)
+		return fallback
+	}
+	return value
+}
+
+func (d *decoder) number(n *node, what string) (float64, error) {
+	number, ok := n.value.(json.Number)
+	if !ok {
+		return 0, d.errorf(n, "%s must be a number, not %s", what, describe(n))
+	}
+	value, err := number.Float64()
+	if err != nil {
+		return 0, d.errorf(n, "%s: %v", what, err)
+	}
+	return value, nil
+}
+
+// vec3 reads an array of three numbers
+func (d *decoder) vec3(n *node, what string) (vec3.Vec3, error) {
+	elements, ok := n.value.([]*node)
+	if !ok || len(elements) != 3 {
+		return vec3.Vec3{}, d.errorf(n, "%s must be an array of 3 numbers", what)
+	}
+
+	var xyz [3]float64
+	for i, element := range elements {
+		value, err := d.number(element, fmt.Sprintf("%s[%d]", what, i))
+		if err != nil {
+			return vec3.Vec3{}, err
+		}
+		xyz[i] = value
+	}
+	return vec3.Vec3{X: xyz[0], Y: xyz[1], Z: xyz[2]}, nil
+}
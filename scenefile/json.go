@@ -0,0 +1,178 @@
+package scenefile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// A node is a parsed JSON value along with the offset it starts at, so errors about it can
+// point at its line and column. encoding/json only reports offsets of syntax errors.
+type node struct {
+	offset int64
+	value  interface{} // nil, bool, json.Number, string, []*node or *object
+}
+
+// object keeps its members in file order
+type object struct {
+	members []member
+}
+
+type member struct {
+	key       string
+	keyOffset int64
+	value     *node
+}
+
+func (o *object) get(key string) *node {
+	for _, m := range o.members {
+		if m.key == key {
+			return m.value
+		}
+	}
+	return nil
+}
+
+// describe names the type of a JSON value for errors
+func describe(n *node) string {
+	switch n.value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case json.Number:
+		return "a number"
+	case string:
+		return "a string"
+	case []*node:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
+
+// parseJSON reads a single JSON value from the decoder's data
+func (d *decoder) parseJSON() (*node, error) {
+	dec := json.NewDecoder(bytes.NewReader(d.data))
+	dec.UseNumber()
+
+	root, err := d.parseValue(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := d.skip(dec.InputOffset())
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, d.errorAt(offset, "unexpected data after the scene")
+	}
+	return root, nil
+}
+
+func (d *decoder) parseValue(dec *json.Decoder) (*node, error) {
+	offset := d.skip(dec.InputOffset())
+	token, err := dec.Token()
+	if err != nil {
+		return nil, d.jsonError(err, offset)
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return &node{offset: offset, value: token}, nil
+	}
+
+	switch delim {
+	case '{':
+		o := &object{}
+		for dec.More() {
+			keyOffset := d.skip(dec.InputOffset())
+			token, err := dec.Token()
+			if err != nil {
+				return nil, d.jsonError(err, keyOffset)
+			}
+			key, ok := token.(string)
+			if !ok {
+				return nil, d.errorAt(keyOffset, "object keys must be strings")
+			}
+			if o.get(key) != nil {
+				return nil, d.errorAt(keyOffset, "duplicate field %q", key)
+			}
+
+			value, err := d.parseValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			o.members = append(o.members, member{key: key, keyOffset: keyOffset, value: value})
+		}
+		if err := d.closing(dec); err != nil {
+			return nil, err
+		}
+		return &node{offset: offset, value: o}, nil
+
+	case '[':
+		elements := make([]*node, 0)
+		for dec.More() {
+			element, err := d.parseValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, element)
+		}
+		if err := d.closing(dec); err != nil {
+			return nil, err
+		}
+		return &node{offset: offset, value: elements}, nil
+
+	default:
+		return nil, d.errorAt(offset, "unexpected %q", rune(delim))
+	}
+}
+
+// closing reads the ] or } ending an array or object
+func (d *decoder) closing(dec *json.Decoder) error {
+	offset := d.skip(dec.InputOffset())
+	if _, err := dec.Token(); err != nil {
+		return d.jsonError(err, offset)
+	}
+	return nil
+}
+
+func (d *decoder) jsonError(err error, offset int64) error {
+	var syntaxError *json.SyntaxError
+	switch {
+	case errors.As(err, &syntaxError) && syntaxError.Offset < int64(len(d.data)):
+		// the offset is just past the offending character
+		return d.errorAt(syntaxError.Offset-1, "%s", syntaxError.Error())
+	case errors.As(err, &syntaxError):
+		return d.errorAt(int64(len(d.data)), "unexpected end of file")
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		return d.errorAt(int64(len(d.data)), "unexpected end of file")
+	default:
+		return d.errorAt(offset, "%s", err.Error())
+	}
+}
+
+// skip returns the offset of the next token after offset, which the decoder reports before
+// whitespace and separators
+func (d *decoder) skip(offset int64) int64 {
+	for offset < int64(len(d.data)) {
+		switch d.data[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// position returns the 1 based line and column, counted in characters, of offset
+func position(data []byte, offset int64) (int, int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	before := data[:offset]
+	line := 1 + bytes.Count(before, []byte("\n"))
+	column := 1 + len(bytes.Runes(before[bytes.LastIndexByte(before, '\n')+1:]))
+	return line, column
+}
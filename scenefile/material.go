@@ -0,0 +1,231 @@
+package scenefile
+
+import (
+	"fmt"
+	"goraytracer/material"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math/rand"
+)
+
+var (
+	white = vec3.Vec3{X: 1, Y: 1, Z: 1}
+	gray  = vec3.Vec3{X: .8, Y: .8, Z: .8}
+)
+
+// kindOf checks that n is an object whose type is one of kinds and returns it
+func (d *decoder) kindOf(n *node, what string, kinds ...string) (string, error) {
+	obj, ok := n.value.(*object)
+	if !ok {
+		return "", d.errorf(n, "%s must be an object, not %s", what, describe(n))
+	}
+
+	// the other fields depend on the type, the caller checks them
+	f := &fields{d: d, n: n, obj: obj}
+	f.require("type")
+	kind := kinds[f.choice("type", kinds...)]
+	return kind, f.err
+}
+
+// texture returns the texture a name refers to, decoding its definition the first time.
+// Checkers refer to other textures, so definitions are decoded in the order they are needed.
+func (d *decoder) texture(ref *node, what string) (texture.Texture, error) {
+	name, ok := ref.value.(string)
+	if !ok {
+		return nil, d.errorf(ref, "%s must name a texture, not %s", what, describe(ref))
+	}
+	def := d.textureDefs.get(name)
+	if def == nil {
+		return nil, d.errorf(ref, "unknown texture %q", name)
+	}
+	if t, ok := d.textures[name]; ok {
+		return t, nil
+	}
+	if d.resolving[name] {
+		return nil, d.errorf(ref, "texture %q refers to itself", name)
+	}
+
+	d.resolving[name] = true
+	t, err := d.decodeTexture(def, fmt.Sprintf("texture %q", name))
+	delete(d.resolving, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.textures[name] = t
+	return t, nil
+}
+
+// colorOrTexture reads a color, as a solid texture, or the name of a texture
+func (d *decoder) colorOrTexture(f *fields, key string) texture.Texture {
+	n := f.get(key)
+	if n == nil {
+		return nil
+	}
+	if _, ok := n.value.([]*node); ok {
+		return texture.Solid{Color: f.vec3(key, vec3.Vec3{})}
+	}
+
+	t, err := d.texture(n, key)
+	f.fail(err)
+	return t
+}
+
+// optionalTexture reads the name of a texture. Without one it returns a nil interface,
+// not a nil *Image, which materials would sample.
+func (d *decoder) optionalTexture(f *fields, key string) texture.Texture {
+	n := f.get(key)
+	if n == nil {
+		return nil
+	}
+
+	t, err := d.texture(n, key)
+	if err != nil {
+		f.fail(err)
+		return nil
+	}
+	return t
+}
+
+func (d *decoder) decodeTexture(n *node, what string) (texture.Texture, error) {
+	kind, err := d.kindOf(n, what, "solid", "checker", "image", "noise", "turbulence", "marble")
+	if err != nil {
+		return nil, err
+	}
+
+	var t texture.Texture
+	var f *fields
+	switch kind {
+	case "solid":
+		f = d.fields(n, what, "type", "color")
+		f.require("color")
+		t = texture.Solid{Color: f.vec3("color", vec3.Vec3{})}
+
+	case "checker":
+		f = d.fields(n, what, "type", "even", "odd", "size")
+		f.require("even", "odd")
+		t = texture.Checker{Even: d.colorOrTexture(f, "even"), Odd: d.colorOrTexture(f, "odd"), Size: f.positive("size", 1)}
+
+	case "image":
+		f = d.fields(n, what, "type", "file", "colorSpace", "wrap", "filter")
+		f.require("file")
+		filename := f.string("file", "")
+		colorSpace := []texture.ColorSpace{texture.SRGB, texture.Linear}[f.choice("colorSpace", "srgb", "linear")]
+		wrap := []texture.WrapMode{texture.Repeat, texture.Clamp}[f.choice("wrap", "repeat", "clamp")]
+		filter := []texture.Filter{texture.Bilinear, texture.Nearest}[f.choice("filter", "bilinear", "nearest")]
+		if f.err != nil {
+			return nil, f.err
+		}
+
+		img, err := texture.LoadImage(d.path(filename), colorSpace)
+		if err != nil {
+			return nil, d.errorf(f.get("file"), "%w", err)
+		}
+		img.Wrap = wrap
+		img.Filter = filter
+		t = img
+
+	case "noise", "turbulence":
+		f = d.fields(n, what, "type", "scale", "octaves", "low", "high", "seed")
+		perlin := texture.NewPerlin(rand.New(rand.NewSource(int64(f.number("seed", 0)))))
+		scale, octaves := f.positive("scale", 1), f.integer("octaves", texture.DefaultOctaves)
+		low, high := f.vec3("low", vec3.Vec3{}), f.vec3("high", white)
+		if kind == "noise" {
+			t = texture.Noise{Perlin: perlin, Scale: scale, Octaves: octaves, Low: low, High: high}
+		} else {
+			t = texture.Turbulence{Perlin: perlin, Scale: scale, Octaves: octaves, Low: low, High: high}
+		}
+
+	case "marble":
+		f = d.fields(n, what, "type", "scale", "distortion", "octaves", "vein", "base", "seed")
+		t = texture.Marble{
+			Perlin:     texture.NewPerlin(rand.New(rand.NewSource(int64(f.number("seed", 0))))),
+			Scale:      f.positive("scale", 1),
+			Distortion: f.number("distortion", 10),
+			Octaves:    f.integer("octaves", texture.DefaultOctaves),
+			Vein:       f.vec3("vein", vec3.Vec3{}),
+			Base:       f.vec3("base", white),
+		}
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	return t, nil
+}
+
+// the fields every material accepts
+var mapFields = []string{"type", "normalMap", "normalScale", "bumpMap", "bumpScale"}
+
+func (d *decoder) decodeMaterial(n *node, what string) (material.Material, error) {
+	kind, err := d.kindOf(n, what, "lambertian", "metal", "dielectric", "pbr")
+	if err != nil {
+		return nil, err
+	}
+
+	var m material.Material
+	var f *fields
+	switch kind {
+	case "lambertian":
+		f = d.fields(n, what, append(mapFields, "albedo", "texture", "emission")...)
+		albedoTexture := d.optionalTexture(f, "texture")
+		m = &material.Lambertian{Properties: material.MaterialProps{
+			Albedo:           f.vec3("albedo", tint(albedoTexture)),
+			BaseColorTexture: albedoTexture,
+			EmittanceColor:   f.vec3("emission", vec3.Vec3{}),
+		}}
+
+	case "metal":
+		f = d.fields(n, what, append(mapFields, "albedo", "texture", "roughness")...)
+		albedoTexture := d.optionalTexture(f, "texture")
+		m = &material.Metal{
+			Albedo:        f.vec3("albedo", tint(albedoTexture)),
+			AlbedoTexture: albedoTexture,
+			Roughness:     f.number("roughness", 0),
+		}
+
+	case "dielectric":
+		f = d.fields(n, what, append(mapFields, "ior")...)
+		m = &material.Dielectric{RefractiveIndex: f.positive("ior", 1.5)}
+
+	case "pbr":
+		f = d.fields(n, what, append(mapFields, "baseColor", "baseColorTexture", "metallic", "roughness",
+			"metallicRoughnessTexture", "emissive", "emissiveTexture")...)
+		m = &material.PBR{
+			BaseColor:                f.vec3("baseColor", white),
+			BaseColorTexture:         d.optionalTexture(f, "baseColorTexture"),
+			Metallic:                 f.number("metallic", 0),
+			Roughness:                f.number("roughness", .5),
+			MetallicRoughnessTexture: d.optionalTexture(f, "metallicRoughnessTexture"),
+			Emissive:                 f.vec3("emissive", vec3.Vec3{}),
+			EmissiveTexture:          d.optionalTexture(f, "emissiveTexture"),
+		}
+	}
+
+	// the maps read a zero scale as 1, but here it asks for a flat surface, so it is left unmapped
+	if normalMap := d.optionalTexture(f, "normalMap"); normalMap != nil {
+		if f.get("bumpMap") != nil {
+			f.fail(d.errorf(f.get("bumpMap"), "a material takes a normalMap or a bumpMap, not both"))
+		}
+		if scale := f.number("normalScale", 1); scale != 0 {
+			m = &material.NormalMap{Material: m, Map: normalMap, Scale: scale}
+		}
+	} else if bumpMap := d.optionalTexture(f, "bumpMap"); bumpMap != nil {
+		if scale := f.number("bumpScale", 1); scale != 0 {
+			m = &material.BumpMap{Material: m, Height: bumpMap, Scale: scale}
+		}
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	return m, nil
+}
+
+// tint is the default color multiplying a texture, white so it shows as it is
+func tint(t texture.Texture) vec3.Vec3 {
+	if t != nil {
+		return white
+	}
+	return gray
+}
@@ -0,0 +1,271 @@
+package scenefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"goraytracer/assets"
+	"goraytracer/geometry"
+	"goraytracer/gltf"
+	"goraytracer/material"
+	"goraytracer/mesh"
+	"goraytracer/obj"
+	"goraytracer/ply"
+	"goraytracer/transform"
+	"goraytracer/vec3"
+	"math"
+	"path/filepath"
+	"strings"
+)
+
+// a mesh definition, loaded once however many objects place it
+type meshSource struct {
+	meshes []mesh.Mesh
+
+	// the first object placing it without a transform uses the geometry as it is,
+	// others get instances of it
+	placed bool
+}
+
+func (d *decoder) meshSource(n *node, what string) (*meshSource, error) {
+	f := d.fields(n, what, "asset", "file", "smooth")
+	asset, file := f.get("asset"), f.get("file")
+	if f.err == nil && (asset == nil) == (file == nil) {
+		f.fail(d.errorf(n, "%s needs either an asset or a file", what))
+	}
+	name := f.string("asset", f.string("file", ""))
+	smooth := f.boolean("smooth", false)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var meshes []mesh.Mesh
+	var err error
+	if asset != nil {
+		if meshes, err = assets.Load(name); err != nil {
+			return nil, d.errorf(asset, "%w, want one of %q", err, assets.Names())
+		}
+	} else {
+		if meshes, err = loadMeshFile(d.path(name)); err != nil {
+			return nil, d.errorf(file, "%w", err)
+		}
+	}
+
+	if smooth {
+		for _, m := range meshes {
+			computeNormals(m.Geometry)
+		}
+	}
+	return &meshSource{meshes: meshes}, nil
+}
+
+// loadMeshFile loads a mesh file by its extension
+func loadMeshFile(filename string) ([]mesh.Mesh, error) {
+	switch extension := strings.ToLower(filepath.Ext(filename)); extension {
+	case ".obj":
+		return obj.Load(filename)
+	case ".ply":
+		m, err := ply.Load(0, filename)
+		if err != nil {
+			return nil, err
+		}
+		return []mesh.Mesh{{Geometry: m, Material: obj.DefaultMaterial()}}, nil
+	case ".gltf", ".glb":
+		scene, err := gltf.Load(filename)
+		if err != nil {
+			return nil, err
+		}
+		return scene.Meshes, nil
+	default:
+		return nil, fmt.Errorf("unknown mesh format %q, want .obj, .ply, .gltf or .glb", extension)
+	}
+}
+
+// computeNormals gives triangle meshes without normals, instanced or not, smooth ones
+func computeNormals(g geometry.Geometry) {
+	switch g := g.(type) {
+	case *geometry.TriangleMesh:
+		if g.Normals == nil {
+			g.ComputeNormals(geometry.AngleWeighted)
+		}
+	case geometry.Instance:
+		computeNormals(g.Geometry)
+	}
+}
+
+func (d *decoder) id() uint32 {
+	id := d.nextId
+	d.nextId++
+	return id
+}
+
+// withId returns geometry loaded from a file with a scene wide id
+func withId(g geometry.Geometry, id uint32) geometry.Geometry {
+	switch g := g.(type) {
+	case *geometry.TriangleMesh:
+		g.Id = id
+		return g
+	case geometry.Instance:
+		g.Id = id
+		return g
+	case geometry.Sphere:
+		g.Id = id
+		return g
+	case geometry.Plane:
+		g.Id = id
+		return g
+	case geometry.Triangle:
+		g.Id = id
+		return g
+	case geometry.Polygon:
+		g.Id = id
+		return g
+	}
+
+	instance, _ := geometry.NewInstance(id, g, transform.Identity())
+	return instance
+}
+
+// material returns the material key refers to, nil when there is no key
+func (d *decoder) material(f *fields, key string) material.Material {
+	n := f.get(key)
+	if n == nil {
+		return nil
+	}
+
+	name, ok := n.value.(string)
+	if !ok {
+		f.fail(d.errorf(n, "%s must name a material, not %s", key, describe(n)))
+		return nil
+	}
+	m, ok := d.materials[name]
+	if !ok {
+		f.fail(d.errorf(n, "unknown material %q", name))
+		return nil
+	}
+	return m
+}
+
+func (d *decoder) object(n *node, what string) ([]mesh.Mesh, error) {
+	kind, err := d.kindOf(n, what, "sphere", "plane", "triangle", "mesh")
+	if err != nil {
+		return nil, err
+	}
+
+	var f *fields
+	var g geometry.Geometry
+	switch kind {
+	case "sphere":
+		f = d.fields(n, what, "type", "center", "radius", "material")
+		f.require("center", "radius", "material")
+		g = geometry.Sphere{Center: f.vec3("center", vec3.Vec3{}), Radius: f.positive("radius", 1)}
+
+	case "plane":
+		f = d.fields(n, what, "type", "point", "normal", "material")
+		f.require("point", "normal", "material")
+		plane := geometry.Plane{Point: f.vec3("point", vec3.Vec3{}), Normal: f.vec3("normal", vec3.Vec3{Y: 1})}
+		if f.err == nil && plane.Normal == (vec3.Vec3{}) {
+			f.fail(d.errorf(f.get("normal"), "normal must not be zero"))
+		}
+		g = plane
+
+	case "triangle":
+		f = d.fields(n, what, "type", "vertices", "material")
+		f.require("vertices", "material")
+		if vertices := f.get("vertices"); vertices != nil {
+			elements, ok := vertices.value.([]*node)
+			if !ok || len(elements) != 3 {
+				return nil, d.errorf(vertices, "vertices must be an array of 3 points")
+			}
+			var p [3]vec3.Vec3
+			for i, element := range elements {
+				if p[i], err = d.vec3(element, fmt.Sprintf("vertices[%d]", i)); err != nil {
+					return nil, err
+				}
+			}
+			g = geometry.NewTriangle(p[0], p[1], p[2])
+		}
+
+	case "mesh":
+		return d.meshObject(n, what)
+	}
+
+	m := d.material(f, "material")
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []mesh.Mesh{{Geometry: withId(g, d.id()), Material: m}}, nil
+}
+
+// meshObject places a mesh definition, with its own materials unless the object overrides them
+func (d *decoder) meshObject(n *node, what string) ([]mesh.Mesh, error) {
+	f := d.fields(n, what, "type", "mesh", "material", "transform")
+	f.require("mesh")
+	name := f.string("mesh", "")
+	override := d.material(f, "material")
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	source, ok := d.meshes[name]
+	if !ok {
+		return nil, d.errorf(f.get("mesh"), "unknown mesh %q", name)
+	}
+
+	objectToWorld := transform.Identity()
+	if n := f.get("transform"); n != nil {
+		var err error
+		if objectToWorld, err = d.transform(n); err != nil {
+			return nil, err
+		}
+	}
+	instanced := source.placed || f.get("transform") != nil
+	source.placed = true
+
+	meshes := make([]mesh.Mesh, len(source.meshes))
+	for i, m := range source.meshes {
+		if instanced {
+			instance, ok := geometry.NewInstance(d.id(), m.Geometry, objectToWorld)
+			if !ok {
+				return nil, d.errorf(f.get("transform"), "the transform can't be inverted")
+			}
+			m.Geometry = instance
+		} else {
+			m.Geometry = withId(m.Geometry, d.id())
+		}
+		if override != nil {
+			m.Material = override
+		}
+		meshes[i] = m
+	}
+	return meshes, nil
+}
+
+// transform reads a scale, a rotation in degrees around x, y and z, and a translation,
+// applied in that order
+func (d *decoder) transform(n *node) (transform.Matrix, error) {
+	f := d.fields(n, "transform", "translate", "rotate", "scale")
+	translation := f.vec3("translate", vec3.Vec3{})
+	rotation := f.vec3("rotate", vec3.Vec3{})
+
+	scale := vec3.Vec3{X: 1, Y: 1, Z: 1}
+	if s := f.get("scale"); s != nil {
+		if _, ok := s.value.(json.Number); ok {
+			factor := f.number("scale", 1)
+			scale = vec3.Vec3{X: factor, Y: factor, Z: factor}
+		} else {
+			scale = f.vec3("scale", scale)
+		}
+	}
+	if f.err != nil {
+		return transform.Matrix{}, f.err
+	}
+
+	radians := math.Pi / 180
+	return transform.Compose(
+		transform.Scale(scale),
+		transform.RotateX(rotation.X*radians),
+		transform.RotateY(rotation.Y*radians),
+		transform.RotateZ(rotation.Z*radians),
+		transform.Translate(translation),
+	), nil
+}
@@ -0,0 +1,305 @@
+// Package scenefile loads scenes described in JSON: render settings, a camera, named textures,
+// materials and meshes, and the objects placing them. Errors point at the line and column
+// of the offending value, including references to names that aren't defined.
+//
+//	{
+//	  "render": {"width": 400, "height": 300, "samples": 100, "maxDepth": 10},
+//	  "camera": {"eye": [0, 1, 4], "look": [0, 0.5, 0], "fov": 40},
+//	  "textures": {
+//	    "checks": {"type": "checker", "even": [0.2, 0.3, 0.1], "odd": [0.9, 0.9, 0.9], "size": 0.5}
+//	  },
+//	  "materials": {
+//	    "ground": {"type": "lambertian", "texture": "checks"},
+//	    "gold": {"type": "pbr", "baseColor": [1, 0.78, 0.34], "metallic": 1, "roughness": 0.3},
+//	    "lamp": {"type": "lambertian", "emission": [4, 4, 4]}
+//	  },
+//	  "meshes": {
+//	    "bunny": {"asset": "bunny"},
+//	    "car": {"file": "models/car.glb"}
+//	  },
+//	  "objects": [
+//	    {"type": "plane", "point": [0, 0, 0], "normal": [0, 1, 0], "material": "ground"},
+//	    {"type": "sphere", "center": [0, 5, 0], "radius": 1, "material": "lamp"},
+//	    {"type": "mesh", "mesh": "bunny", "material": "gold", "transform": {"rotate": [0, 30, 0]}},
+//	    {"type": "mesh", "mesh": "car", "transform": {"translate": [2, 0, 0], "scale": 0.5}}
+//	  ]
+//	}
+//
+// Render settings default to 320 by 240 pixels, 50 samples per pixel, a maximum depth of 10,
+// the bvh accelerator and image.ppm as output. The camera needs eye, look and a vertical fov in
+// degrees, up defaults to +y and can't point along the view.
+//
+// Textures are "solid" (color), "checker" (even, odd: colors or texture names, size), "image"
+// (file, colorSpace: srgb or linear, wrap: repeat or clamp, filter: bilinear or nearest), "noise"
+// and "turbulence" (scale, octaves, low, high, seed) or "marble" (scale, distortion, octaves,
+// vein, base, seed).
+//
+// Materials are "lambertian" (albedo, texture, emission), "metal" (albedo, texture, roughness),
+// "dielectric" (ior) or "pbr" (baseColor, baseColorTexture, metallic, roughness,
+// metallicRoughnessTexture, emissive, emissiveTexture). Any of them can take a normalMap and
+// normalScale or a bumpMap and bumpScale. The scales default to 1, a scale of 0 leaves the
+// surface flat.
+//
+// Meshes are an embedded asset (see package assets) or an OBJ, PLY, glTF or GLB file, relative to
+// the scene file. They keep the materials they come with unless an object overrides them.
+// With "smooth": true, meshes without normals get angle weighted vertex normals.
+//
+// Objects are "sphere" (center, radius), "plane" (point, normal), "triangle" (vertices) or "mesh"
+// (mesh, an optional material and transform). A transform scales, by a number or per axis,
+// rotates by degrees around x, then y, then z, and translates. Meshes placed more than once or
+// transformed become instances sharing their geometry.
+package scenefile
+
+import (
+	"fmt"
+	"goraytracer/camera"
+	"goraytracer/material"
+	"goraytracer/mesh"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"os"
+	"path/filepath"
+)
+
+// ParseError reports an invalid value and where it is.
+type ParseError struct {
+	Filename string
+	Line     int
+	Column   int
+	Err      error
+}
+
+func (err *ParseError) Error() string {
+	return fmt.Sprintf("scenefile: %s:%d:%d: %v", err.Filename, err.Line, err.Column, err.Err)
+}
+
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// Settings are how a scene is rendered.
+type Settings struct {
+	Width, Height int // in pixels, at least 2 since rays span from the first pixel to the last
+	Samples       int // per pixel
+	MaxDepth      int // bounces per path
+	Accelerator   string
+	Output        string
+}
+
+// AspectRatio is the ratio of width to height.
+func (settings Settings) AspectRatio() float64 {
+	return float64(settings.Width) / float64(settings.Height)
+}
+
+// A Scene is everything needed to render an image.
+type Scene struct {
+	Settings Settings
+	Camera   camera.Camera
+	Meshes   []mesh.Mesh
+}
+
+// decoder holds what has been read of one scene file
+type decoder struct {
+	filename string
+	dir      string
+	data     []byte
+
+	textureDefs *object
+	textures    map[string]texture.Texture
+	resolving   map[string]bool
+	materials   map[string]material.Material
+	meshes      map[string]*meshSource
+
+	// geometry ids are unique across the scene
+	nextId uint32
+}
+
+// Load reads a scene file. Files it refers to are relative to it.
+func Load(filename string) (*Scene, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data, filename)
+}
+
+// Parse reads a scene from data, resolving files it refers to relative to the directory
+// of filename.
+func Parse(data []byte, filename string) (*Scene, error) {
+	d := &decoder{
+		filename:    filename,
+		dir:         filepath.Dir(filename),
+		data:        data,
+		textureDefs: &object{},
+		textures:    make(map[string]texture.Texture),
+		resolving:   make(map[string]bool),
+		materials:   make(map[string]material.Material),
+		meshes:      make(map[string]*meshSource),
+	}
+
+	root, err := d.parseJSON()
+	if err != nil {
+		return nil, err
+	}
+	return d.scene(root)
+}
+
+func (d *decoder) errorAt(offset int64, format string, args ...interface{}) error {
+	line, column := position(d.data, offset)
+	return &ParseError{Filename: d.filename, Line: line, Column: column, Err: fmt.Errorf(format, args...)}
+}
+
+func (d *decoder) errorf(n *node, format string, args ...interface{}) error {
+	return d.errorAt(n.offset, format, args...)
+}
+
+// path resolves a file name from the scene relative to the scene file
+func (d *decoder) path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(d.dir, filepath.FromSlash(name))
+}
+
+func (d *decoder) scene(root *node) (*Scene, error) {
+	f := d.fields(root, "the scene", "render", "camera", "textures", "materials", "meshes", "objects")
+	f.require("camera")
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	scene := &Scene{}
+	var err error
+	if scene.Settings, err = d.settings(f.get("render")); err != nil {
+		return nil, err
+	}
+	if scene.Camera, err = d.camera(f.get("camera"), scene.Settings.AspectRatio()); err != nil {
+		return nil, err
+	}
+
+	// textures are decoded when first used, this catches errors in unused ones
+	if n := f.get("textures"); n != nil {
+		if d.textureDefs, err = d.members(n, "textures"); err != nil {
+			return nil, err
+		}
+		for _, m := range d.textureDefs.members {
+			if _, err := d.texture(&node{offset: m.keyOffset, value: m.key}, "texture"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if n := f.get("materials"); n != nil {
+		if err := d.forEach(n, "materials", func(name string, def *node) error {
+			m, err := d.decodeMaterial(def, fmt.Sprintf("material %q", name))
+			if err != nil {
+				return err
+			}
+			d.materials[name] = m
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if n := f.get("meshes"); n != nil {
+		if err := d.forEach(n, "meshes", func(name string, def *node) error {
+			source, err := d.meshSource(def, fmt.Sprintf("mesh %q", name))
+			if err != nil {
+				return err
+			}
+			d.meshes[name] = source
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if n := f.get("objects"); n != nil {
+		objects, ok := n.value.([]*node)
+		if !ok {
+			return nil, d.errorf(n, "objects must be an array, not %s", describe(n))
+		}
+		for i, object := range objects {
+			meshes, err := d.object(object, fmt.Sprintf("object %d", i))
+			if err != nil {
+				return nil, err
+			}
+			scene.Meshes = append(scene.Meshes, meshes...)
+		}
+	}
+
+	return scene, nil
+}
+
+// members returns n as an object of definitions, whose keys are names
+func (d *decoder) members(n *node, what string) (*object, error) {
+	obj, ok := n.value.(*object)
+	if !ok {
+		return nil, d.errorf(n, "%s must be an object, not %s", what, describe(n))
+	}
+	return obj, nil
+}
+
+// forEach calls fn with each definition in n, in order
+func (d *decoder) forEach(n *node, what string, fn func(name string, value *node) error) error {
+	obj, err := d.members(n, what)
+	if err != nil {
+		return err
+	}
+	for _, m := range obj.members {
+		if err := fn(m.key, m.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *decoder) settings(n *node) (Settings, error) {
+	settings := Settings{Width: 320, Height: 240, Samples: 50, MaxDepth: 10, Accelerator: "bvh", Output: "image.ppm"}
+	if n == nil {
+		return settings, nil
+	}
+
+	f := d.fields(n, "render", "width", "height", "samples", "maxDepth", "accelerator", "output")
+	settings.Width = f.integer("width", settings.Width)
+	settings.Height = f.integer("height", settings.Height)
+	if settings.Width < 2 {
+		f.fail(d.errorf(f.get("width"), "width must be at least 2 pixels"))
+	}
+	if settings.Height < 2 {
+		f.fail(d.errorf(f.get("height"), "height must be at least 2 pixels"))
+	}
+	settings.Samples = f.integer("samples", settings.Samples)
+	settings.MaxDepth = f.integer("maxDepth", settings.MaxDepth)
+	settings.Accelerator = []string{"bvh", "octree"}[f.choice("accelerator", "bvh", "octree")]
+	settings.Output = f.string("output", settings.Output)
+	return settings, f.err
+}
+
+func (d *decoder) camera(n *node, aspectRatio float64) (camera.Camera, error) {
+	f := d.fields(n, "camera", "eye", "look", "up", "fov")
+	f.require("eye", "look", "fov")
+	eye, look, up := f.vec3("eye", vec3.Vec3{}), f.vec3("look", vec3.Vec3{}), f.vec3("up", vec3.Vec3{Y: 1})
+	fov := f.positive("fov", 45)
+	if f.err != nil {
+		return camera.Camera{}, f.err
+	}
+
+	if fov >= 180 {
+		return camera.Camera{}, d.errorf(f.get("fov"), "fov must be less than 180 degrees")
+	}
+	if eye == look {
+		return camera.Camera{}, d.errorf(f.get("look"), "the camera looks at its own eye")
+	}
+
+	// up only orients the view when it leaves the view direction
+	if cross := vec3.Cross(vec3.Sub(look, eye).Normalized(), up); up.NearZero() || cross.NearZero() {
+		n := f.get("up")
+		if n == nil {
+			n = f.get("look")
+		}
+		return camera.Camera{}, d.errorf(n, "up is parallel to the direction from eye to look")
+	}
+	return camera.NewWithUp(eye, look, up, fov, aspectRatio), nil
+}
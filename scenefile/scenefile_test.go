@@ -0,0 +1,303 @@
+package scenefile_test
+
+import (
+	"errors"
+	"goraytracer/accel"
+	"goraytracer/geometry"
+	"goraytracer/material"
+	"goraytracer/ray"
+	"goraytracer/scenefile"
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const tent = `v 0 0 0
+v 1 0 0
+v 0 1 -1
+v 1 1 -1
+v 0 0 -2
+v 1 0 -2
+f 1 2 4 3
+f 3 4 6 5
+`
+
+func writeFile(t *testing.T, filename string, content string) {
+	t.Helper()
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "tent.obj"), tent)
+	writeFile(t, filepath.Join(dir, "scene.json"), `{
+  "render": {"width": 64, "height": 32, "samples": 4, "accelerator": "octree"},
+  "camera": {"eye": [0, 1, 5], "look": [0, 1, 0], "fov": 40},
+  "textures": {
+    "checks": {"type": "checker", "even": "dark", "odd": [1, 1, 1]},
+    "dark": {"type": "solid", "color": [0.1, 0.1, 0.1]},
+    "stone": {"type": "marble", "seed": 3}
+  },
+  "materials": {
+    "ground": {"type": "lambertian", "texture": "checks"},
+    "lamp": {"type": "lambertian", "emission": [4, 4, 4]},
+    "steel": {"type": "metal", "roughness": 0.2, "bumpMap": "stone"},
+    "glass": {"type": "dielectric"},
+    "gold": {"type": "pbr", "baseColor": [1, 0.8, 0.3], "metallic": 1}
+  },
+  "meshes": {
+    "tent": {"file": "tent.obj", "smooth": true},
+    "bunny": {"asset": "bunny"}
+  },
+  "objects": [
+    {"type": "plane", "point": [0, 0, 0], "normal": [0, 1, 0], "material": "ground"},
+    {"type": "sphere", "center": [0, 5, 0], "radius": 1, "material": "lamp"},
+    {"type": "triangle", "vertices": [[0, 0, 0], [1, 0, 0], [0, 1, 0]], "material": "glass"},
+    {"type": "mesh", "mesh": "tent", "material": "steel"},
+    {"type": "mesh", "mesh": "tent", "transform": {"translate": [3, 0, 0], "rotate": [0, 90, 0], "scale": 2}},
+    {"type": "mesh", "mesh": "bunny", "material": "gold", "transform": {"scale": [1, 2, 1]}}
+  ]
+}`)
+
+	scene, err := scenefile.Load(filepath.Join(dir, "scene.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := scenefile.Settings{Width: 64, Height: 32, Samples: 4, MaxDepth: 10, Accelerator: "octree", Output: "image.ppm"}
+	if scene.Settings != want {
+		t.Errorf("Settings = %+v, want %+v", scene.Settings, want)
+	}
+	if len(scene.Meshes) != 6 {
+		t.Fatalf("got %d meshes, want 6", len(scene.Meshes))
+	}
+
+	ids := make(map[uint32]bool)
+	for i, m := range scene.Meshes {
+		if ids[m.Geometry.GetId()] {
+			t.Errorf("mesh %d reuses id %d", i, m.Geometry.GetId())
+		}
+		ids[m.Geometry.GetId()] = true
+	}
+	if _, ok := scene.Meshes[0].Geometry.(geometry.Plane); !ok {
+		t.Errorf("the ground is %T, want a plane", scene.Meshes[0].Geometry)
+	}
+	if _, ok := scene.Meshes[2].Geometry.(geometry.Triangle); !ok {
+		t.Errorf("the glass is %T, want a triangle", scene.Meshes[2].Geometry)
+	}
+
+	ground, ok := scene.Meshes[0].Material.(*material.Lambertian)
+	if !ok || ground.Properties.Albedo != (vec3.Vec3{X: 1, Y: 1, Z: 1}) {
+		t.Errorf("ground = %#v", scene.Meshes[0].Material)
+	} else if checker, ok := ground.Properties.BaseColorTexture.(texture.Checker); !ok || checker.Even != (texture.Solid{Color: vec3.Vec3{X: .1, Y: .1, Z: .1}}) {
+		t.Errorf("ground texture = %#v", ground.Properties.BaseColorTexture)
+	}
+
+	tent, ok := scene.Meshes[3].Geometry.(*geometry.TriangleMesh)
+	if !ok || len(tent.Normals) != len(tent.Positions) {
+		t.Errorf("the first tent is %#v, want a smooth mesh", scene.Meshes[3].Geometry)
+	}
+	if bump, ok := scene.Meshes[3].Material.(*material.BumpMap); !ok || bump.Scale != 1 {
+		t.Errorf("steel = %#v", scene.Meshes[3].Material)
+	}
+	if _, ok := scene.Meshes[4].Geometry.(geometry.Instance); !ok {
+		t.Errorf("the second tent is %T, want an instance", scene.Meshes[4].Geometry)
+	}
+	if _, ok := scene.Meshes[4].Material.(*material.Lambertian); !ok {
+		t.Errorf("the second tent keeps its file's material, got %#v", scene.Meshes[4].Material)
+	}
+	if _, ok := scene.Meshes[5].Material.(*material.PBR); !ok {
+		t.Errorf("bunny = %#v", scene.Meshes[5].Material)
+	}
+
+	// the instanced tent was scaled by 2 to 2x2x4, turned so its length runs along x and moved 3 along x
+	if bounds := scene.Meshes[4].Geometry.BoundingBox(); math.Abs(bounds.Min.X+1) > 1e-9 || math.Abs(bounds.Max.X-3) > 1e-9 || math.Abs(bounds.Min.Z+2) > 1e-9 {
+		t.Errorf("the second tent's bounds are %+v", bounds)
+	}
+
+	// the camera looks down -z at the lamp's height, where the bunny stretched to 2 tall stands
+	// in front of the glass triangle
+	bvh := accel.BuildBVH(scene.Meshes)
+	hit, m := bvh.ClosestHit(scene.Camera.GetRay(.5, .5), .001, math.Inf(1))
+	if !hit.Hit || m != scene.Meshes[5].Material || math.Abs(hit.Point.Y-1) > 1e-6 || hit.Point.Z <= 0 {
+		t.Errorf("center ray hit %+v of %#v, want the bunny", hit, m)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "syntax", source: "{\n  \"camera\": {,}\n}", wantErr: "bad.json:2:14: invalid character ','"},
+		{name: "end of file", source: "{\"camera\": {", wantErr: "bad.json:1:13: unexpected end of file"},
+		{name: "trailing data", source: "{} {}", wantErr: "bad.json:1:4: unexpected data after the scene"},
+		{name: "not an object", source: "[]", wantErr: "bad.json:1:1: the scene must be an object, not an array"},
+		{name: "no camera", source: "{}", wantErr: `bad.json:1:1: missing field "camera"`},
+		{name: "unknown field", source: "{\n\t\"camera\": {},\n\t\"lights\": []\n}", wantErr: `bad.json:3:2: unknown field "lights" in the scene`},
+		{name: "duplicate field", source: `{"camera": {}, "camera": {}}`, wantErr: `bad.json:1:16: duplicate field "camera"`},
+		{name: "camera field", source: `{"camera": {"eye": [0, 0], "look": [0, 0, 0], "fov": 45}}`, wantErr: "bad.json:1:20: eye must be an array of 3 numbers"},
+		{name: "vector element", source: `{"camera": {"eye": [0, "1", 2], "look": [0, 0, 0], "fov": 45}}`, wantErr: "bad.json:1:24: eye[1] must be a number, not a string"},
+		{name: "fov", source: `{"camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "fov": -45}}`, wantErr: "bad.json:1:57: fov must be positive"},
+		{name: "up along the view", source: `{"camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "up": [0, 0, 2], "fov": 45}}`, wantErr: "bad.json:1:56: up is parallel to the direction from eye to look"},
+		{name: "looking along the default up", source: `{"camera": {"eye": [0, 0, 0], "look": [0, 3, 0], "fov": 45}}`, wantErr: "bad.json:1:39: up is parallel to the direction from eye to look"},
+		{name: "zero up", source: `{"camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "up": [0, 0, 0], "fov": 45}}`, wantErr: "bad.json:1:56: up is parallel to the direction from eye to look"},
+		{
+			name:    "width",
+			source:  `{"render": {"width": 10.5}, "camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "fov": 45}}`,
+			wantErr: "bad.json:1:22: width must be a whole number",
+		},
+		{
+			name:    "one pixel high",
+			source:  `{"render": {"width": 10, "height": 1}, "camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "fov": 45}}`,
+			wantErr: "bad.json:1:36: height must be at least 2 pixels",
+		},
+		{
+			name:    "accelerator",
+			source:  `{"render": {"accelerator": "kd"}, "camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "fov": 45}}`,
+			wantErr: `bad.json:1:28: unknown accelerator "kd", want one of ["bvh" "octree"]`,
+		},
+	}
+
+	// scenes with a valid camera on the first line
+	const camera = `{"camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "fov": 45},` + "\n"
+	scenes := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "unknown texture type", source: `"textures": {"a": {"type": "wood"}}}`, wantErr: `bad.json:2:28: unknown type "wood"`},
+		{name: "unknown texture", source: `"textures": {"a": {"type": "checker", "even": "b", "odd": [0, 0, 0]}}}`, wantErr: `bad.json:2:47: unknown texture "b"`},
+		{name: "texture cycle", source: `"textures": {"a": {"type": "checker", "even": "a", "odd": "a"}}}`, wantErr: `bad.json:2:47: texture "a" refers to itself`},
+		{name: "missing image", source: `"textures": {"a": {"type": "image", "file": "missing.png"}}}`, wantErr: "bad.json:2:45: open"},
+		{name: "material type", source: `"materials": {"a": {"albedo": [1, 1, 1]}}}`, wantErr: `bad.json:2:20: missing field "type"`},
+		{name: "material field", source: `"materials": {"a": {"type": "metal", "ior": 1.5}}}`, wantErr: `bad.json:2:38: unknown field "ior" in material "a"`},
+		{name: "material texture", source: `"materials": {"a": {"type": "lambertian", "texture": "b"}}}`, wantErr: `bad.json:2:54: unknown texture "b"`},
+		{name: "both maps", source: `"textures": {"t": {"type": "solid", "color": [1, 1, 1]}},
+"materials": {"a": {"type": "pbr", "normalMap": "t", "bumpMap": "t"}}}`, wantErr: "bad.json:3:65: a material takes a normalMap or a bumpMap, not both"},
+		{name: "mesh source", source: `"meshes": {"a": {"asset": "bunny", "file": "bunny.ply"}}}`, wantErr: `bad.json:2:17: mesh "a" needs either an asset or a file`},
+		{name: "unknown asset", source: `"meshes": {"a": {"asset": "dragon"}}}`, wantErr: `bad.json:2:27: assets: unknown asset "dragon", want one of ["bunny" "teapot" "cornell_box"]`},
+		{name: "mesh format", source: `"meshes": {"a": {"file": "a.stl"}}}`, wantErr: `bad.json:2:26: unknown mesh format ".stl"`},
+		{name: "objects", source: `"objects": {}}`, wantErr: "bad.json:2:12: objects must be an array, not an object"},
+		{name: "object type", source: `"objects": [{"type": "cube"}]}`, wantErr: `bad.json:2:22: unknown type "cube"`},
+		{name: "radius", source: `"objects": [{"type": "sphere", "center": [0, 0, 0], "radius": 0, "material": "a"}]}`, wantErr: "bad.json:2:63: radius must be positive"},
+		{name: "unknown material", source: `"objects": [{"type": "sphere", "center": [0, 0, 0], "radius": 1, "material": "a"}]}`, wantErr: `bad.json:2:78: unknown material "a"`},
+		{name: "zero normal", source: `"objects": [{"type": "plane", "point": [0, 0, 0], "normal": [0, 0, 0], "material": "a"}]}`, wantErr: "bad.json:2:61: normal must not be zero"},
+		{name: "triangle", source: `"objects": [{"type": "triangle", "vertices": [[0, 0, 0]], "material": "a"}]}`, wantErr: "bad.json:2:46: vertices must be an array of 3 points"},
+		{name: "unknown mesh", source: `"objects": [{"type": "mesh", "mesh": "a"}]}`, wantErr: `bad.json:2:38: unknown mesh "a"`},
+		{
+			name:    "singular transform",
+			source:  `"meshes": {"a": {"asset": "teapot"}}, "objects": [{"type": "mesh", "mesh": "a", "transform": {"scale": 0}}]}`,
+			wantErr: "bad.json:2:94: the transform can't be inverted",
+		},
+	}
+	for _, tt := range scenes {
+		tests = append(tests, struct {
+			name    string
+			source  string
+			wantErr string
+		}{name: tt.name, source: camera + tt.source, wantErr: tt.wantErr})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := scenefile.Parse([]byte(tt.source), "bad.json")
+			var parseError *scenefile.ParseError
+			if !errors.As(err, &parseError) || !strings.HasPrefix(err.Error(), "scenefile: "+tt.wantErr) {
+				t.Errorf("Parse() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParse_MissingMeshFile(t *testing.T) {
+	source := `{"camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "fov": 45}, "meshes": {"a": {"file": "a.ply"}}}`
+	if _, err := scenefile.Parse([]byte(source), filepath.Join(t.TempDir(), "scene.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Parse() error = %v, want a missing file", err)
+	}
+}
+
+func TestParse_PlacedMeshesCanBeHit(t *testing.T) {
+	source := `{
+  "camera": {"eye": [0, 0, 5], "look": [0, 0, 0], "fov": 45},
+  "materials": {"gold": {"type": "pbr", "baseColor": [1, 0.8, 0.3], "metallic": 1}},
+  "meshes": {"bunny": {"asset": "bunny"}},
+  "objects": [
+    {"type": "mesh", "mesh": "bunny"},
+    {"type": "mesh", "mesh": "bunny"},
+    {"type": "mesh", "mesh": "bunny", "material": "gold", "transform": {"translate": [3, 0, 0], "scale": 2}}
+  ]
+}`
+	scene, err := scenefile.Parse([]byte(source), "scene.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scene.Meshes) != 3 {
+		t.Fatalf("got %d meshes, want 3", len(scene.Meshes))
+	}
+
+	// the first placement is the mesh itself, the repeated one an instance in the same place
+	// and the transformed one twice as large
+	first := ray.New(vec3.Vec3{Y: .3, Z: 5}, vec3.Vec3{Z: -1})
+	plain := scene.Meshes[0].Geometry.Hit(first, .001, math.Inf(1))
+	if !plain.Hit {
+		t.Fatal("missed the bunny")
+	}
+	if repeated := scene.Meshes[1].Geometry.Hit(first, .001, math.Inf(1)); !repeated.Hit || math.Abs(repeated.Distance-plain.Distance) > 1e-9 {
+		t.Errorf("repeated bunny hit = %+v, want the first bunny's at %v", repeated, plain.Distance)
+	}
+
+	transformed := ray.New(vec3.Vec3{X: 3, Y: .6, Z: 5}, vec3.Vec3{Z: -1})
+	hit := scene.Meshes[2].Geometry.Hit(transformed, .001, math.Inf(1))
+	if !hit.Hit || math.Abs(hit.Point.Z-2*plain.Point.Z) > 1e-9 {
+		t.Errorf("transformed bunny hit = %+v, want it at z = %v", hit, 2*plain.Point.Z)
+	}
+
+	bvh := accel.BuildBVH(scene.Meshes)
+	if _, m := bvh.ClosestHit(transformed, .001, math.Inf(1)); m != scene.Meshes[2].Material {
+		t.Errorf("the transformed bunny's ray hit %#v", m)
+	}
+}
+
+func TestParse_ZeroMapScaleLeavesSurfaceFlat(t *testing.T) {
+	source := `{
+  "camera": {"eye": [0, 0, 1], "look": [0, 0, 0], "fov": 45},
+  "textures": {"t": {"type": "solid", "color": [0.5, 0.5, 1]}},
+  "materials": {
+    "bumped": {"type": "lambertian", "bumpMap": "t", "bumpScale": 0},
+    "mapped": {"type": "lambertian", "normalMap": "t", "normalScale": 0}
+  },
+  "objects": [
+    {"type": "sphere", "center": [0, 0, 0], "radius": 1, "material": "bumped"},
+    {"type": "sphere", "center": [0, 0, 0], "radius": 1, "material": "mapped"}
+  ]
+}`
+	scene, err := scenefile.Parse([]byte(source), "scene.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, m := range scene.Meshes {
+		if _, ok := m.Material.(*material.Lambertian); !ok {
+			t.Errorf("mesh %d has %#v, want an unmapped material", i, m.Material)
+		}
+	}
+}
+
+func TestLoad_Examples(t *testing.T) {
+	examples, err := filepath.Glob("../scenes/*.json")
+	if err != nil || len(examples) == 0 {
+		t.Fatalf("no example scenes: %v", err)
+	}
+	for _, example := range examples {
+		if _, err := scenefile.Load(example); err != nil {
+			t.Error(err)
+		}
+	}
+}
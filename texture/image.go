@@ -0,0 +1,158 @@
+package texture
+
+import (
+	"fmt"
+	"goraytracer/vec3"
+	"image"
+	"image/color"
+	_ "image/jpeg" // register the decoders image.Decode uses
+	_ "image/png"
+	"math"
+	"os"
+)
+
+// WrapMode decides what texture coordinates outside of [0, 1] sample.
+type WrapMode int
+
+const (
+	Repeat WrapMode = iota // tile the image
+	Clamp                  // extend the edge texels
+)
+
+type Filter int
+
+const (
+	Bilinear Filter = iota
+	Nearest
+)
+
+// ColorSpace is the encoding of the values stored in an image file.
+type ColorSpace int
+
+const (
+	SRGB   ColorSpace = iota // colors, like base color textures
+	Linear                   // data, like normal or roughness maps
+)
+
+// Image is a texture sampled by UV coordinates, with (0, 0) at the bottom left of
+// the image and (1, 1) at the top right. Texels are stored as linear RGB.
+type Image struct {
+	Width  int
+	Height int
+	Texels []vec3.Vec3 // row major, starting at the top row
+
+	Wrap   WrapMode
+	Filter Filter
+}
+
+// LoadImage reads a PNG or JPEG file.
+func LoadImage(filename string, colorSpace ColorSpace) (*Image, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("texture: decoding %s: %w", filename, err)
+	}
+
+	return NewImage(img, colorSpace), nil
+}
+
+// NewImage converts img to a texture, decoding sRGB values to linear ones if needed.
+func NewImage(img image.Image, colorSpace ColorSpace) *Image {
+	bounds := img.Bounds()
+	texture := &Image{
+		Width:  bounds.Dx(),
+		Height: bounds.Dy(),
+		Texels: make([]vec3.Vec3, bounds.Dx()*bounds.Dy()),
+	}
+
+	decode := func(c uint16) float64 {
+		return float64(c) / 0xffff
+	}
+	if colorSpace == SRGB {
+		decode = func(c uint16) float64 {
+			return srgbToLinear(float64(c) / 0xffff)
+		}
+	}
+
+	for y := 0; y < texture.Height; y++ {
+		for x := 0; x < texture.Width; x++ {
+			// un-premultiply alpha, transparency is ignored
+			c := color.NRGBA64Model.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA64)
+			texture.Texels[y*texture.Width+x] = vec3.Vec3{X: decode(c.R), Y: decode(c.G), Z: decode(c.B)}
+		}
+	}
+
+	return texture
+}
+
+// https://en.wikipedia.org/wiki/SRGB#From_sRGB_to_CIE_XYZ
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// returns the texel at x, y, after applying the wrap mode to both
+func (texture *Image) texel(x int, y int) vec3.Vec3 {
+	switch texture.Wrap {
+	case Clamp:
+		x = clampInt(x, 0, texture.Width-1)
+		y = clampInt(y, 0, texture.Height-1)
+	default:
+		x = modInt(x, texture.Width)
+		y = modInt(y, texture.Height)
+	}
+	return texture.Texels[y*texture.Width+x]
+}
+
+func clampInt(x int, min int, max int) int {
+	if x < min {
+		return min
+	}
+	if x > max {
+		return max
+	}
+	return x
+}
+
+// the remainder with the sign of m, so negative coordinates repeat as well
+func modInt(x int, m int) int {
+	x %= m
+	if x < 0 {
+		x += m
+	}
+	return x
+}
+
+// Sample returns the filtered color at u, v.
+func (texture *Image) Sample(u float64, v float64) vec3.Vec3 {
+	if texture.Width == 0 || texture.Height == 0 {
+		return vec3.Vec3{}
+	}
+
+	// texel space, with texel centers at integer + .5 and the top row first
+	x := u * float64(texture.Width)
+	y := (1 - v) * float64(texture.Height)
+
+	if texture.Filter == Nearest {
+		return texture.texel(int(math.Floor(x)), int(math.Floor(y)))
+	}
+
+	x -= .5
+	y -= .5
+	x0 := math.Floor(x)
+	y0 := math.Floor(y)
+	fx := x - x0
+	fy := y - y0
+
+	top := vec3.Lerp(texture.texel(int(x0), int(y0)), texture.texel(int(x0)+1, int(y0)), fx)
+	bottom := vec3.Lerp(texture.texel(int(x0), int(y0)+1), texture.texel(int(x0)+1, int(y0)+1), fx)
+	return vec3.Lerp(top, bottom, fy)
+}
@@ -0,0 +1,133 @@
+package texture_test
+
+import (
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func nearVec3(a vec3.Vec3, b vec3.Vec3) bool {
+	return vec3.Sub(a, b).Length() < .000001
+}
+
+// a 2x2 image: black and red on top, green and blue at the bottom
+func checkerImage() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.NRGBA{A: 255})
+	img.Set(1, 0, color.NRGBA{R: 255, A: 255})
+	img.Set(0, 1, color.NRGBA{G: 255, A: 255})
+	img.Set(1, 1, color.NRGBA{B: 255, A: 255})
+	return img
+}
+
+var (
+	black = vec3.Vec3{}
+	red   = vec3.Vec3{X: 1}
+	green = vec3.Vec3{Y: 1}
+	blue  = vec3.Vec3{Z: 1}
+)
+
+func TestImage_TexelCenters(t *testing.T) {
+	tex := texture.NewImage(checkerImage(), texture.Linear)
+
+	tests := []struct {
+		name string
+		u, v float64
+		want vec3.Vec3
+	}{
+		{name: "top left", u: .25, v: .75, want: black},
+		{name: "top right", u: .75, v: .75, want: red},
+		{name: "bottom left", u: .25, v: .25, want: green},
+		{name: "bottom right", u: .75, v: .25, want: blue},
+	}
+	for _, filter := range []texture.Filter{texture.Bilinear, texture.Nearest} {
+		tex.Filter = filter
+		for _, tt := range tests {
+			if got := tex.Sample(tt.u, tt.v); !nearVec3(got, tt.want) {
+				t.Errorf("filter %d %s: Sample() = %v, want %v", filter, tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestImage_BilinearBlendsNeighbours(t *testing.T) {
+	tex := texture.NewImage(checkerImage(), texture.Linear)
+	tex.Wrap = texture.Clamp
+
+	if got := tex.Sample(.5, .5); !nearVec3(got, vec3.Vec3{X: .25, Y: .25, Z: .25}) {
+		t.Error("center", got)
+	}
+	if got := tex.Sample(.5, .75); !nearVec3(got, vec3.Vec3{X: .5}) {
+		t.Error("between the top texels", got)
+	}
+
+	tex.Filter = texture.Nearest
+	if got := tex.Sample(.49, .74); got != black {
+		t.Error("nearest", got)
+	}
+}
+
+func TestImage_WrapModes(t *testing.T) {
+	tex := texture.NewImage(checkerImage(), texture.Linear)
+
+	// at the left edge, repeating blends in the right column and clamping doesn't
+	tex.Wrap = texture.Repeat
+	if got := tex.Sample(0, .75); !nearVec3(got, vec3.Vec3{X: .5}) {
+		t.Error("repeat edge", got)
+	}
+	if got := tex.Sample(-.75, -1.75); !nearVec3(got, green) {
+		t.Error("repeat negative", got)
+	}
+	if got := tex.Sample(2.75, 3.75); !nearVec3(got, red) {
+		t.Error("repeat positive", got)
+	}
+
+	tex.Wrap = texture.Clamp
+	if got := tex.Sample(0, .75); !nearVec3(got, black) {
+		t.Error("clamp edge", got)
+	}
+	if got := tex.Sample(5, -5); !nearVec3(got, blue) {
+		t.Error("clamp outside", got)
+	}
+}
+
+func TestLoadImage_DecodesSRGB(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, color.NRGBA{R: 255, G: 128, B: 0, A: 255})
+
+	filename := filepath.Join(t.TempDir(), "texture.png")
+	f, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	srgb, err := texture.LoadImage(filename, texture.SRGB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	linear, err := texture.LoadImage(filename, texture.Linear)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := srgb.Sample(.5, .5); got.X != 1 || math.Abs(got.Y-.2158605) > .0001 || got.Z != 0 {
+		t.Error("srgb", got)
+	}
+	if got := linear.Sample(.5, .5); got.X != 1 || math.Abs(got.Y-128.0/255) > .0001 || got.Z != 0 {
+		t.Error("linear", got)
+	}
+
+	if _, err := texture.LoadImage(filepath.Join(t.TempDir(), "missing.png"), texture.SRGB); err == nil {
+		t.Error("loaded a missing file")
+	}
+}
@@ -0,0 +1,182 @@
+package texture
+
+import (
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+)
+
+// DefaultOctaves is used by noise textures that leave Octaves at zero.
+const DefaultOctaves = 7
+
+// Perlin is gradient noise, smooth and random looking in three dimensions.
+// https://mrl.cs.nyu.edu/~perlin/noise/
+type Perlin struct {
+	permutation [512]int
+}
+
+// NewPerlin shuffles a permutation table with random, so every seed gives different noise.
+func NewPerlin(random *rand.Rand) *Perlin {
+	perlin := &Perlin{}
+	for i, p := range random.Perm(256) {
+		perlin.permutation[i] = p
+		perlin.permutation[i+256] = p
+	}
+	return perlin
+}
+
+// smootherstep, with zero first and second derivatives at 0 and 1
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// dot product of x, y, z with one of 12 gradients picked by hash
+func gradient(hash int, x float64, y float64, z float64) float64 {
+	h := hash & 15
+	u := y
+	if h < 8 {
+		u = x
+	}
+	v := z
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
+
+func lerp(t float64, a float64, b float64) float64 {
+	return a + t*(b-a)
+}
+
+// Noise returns a value in about [-1, 1], zero at every integer lattice point.
+func (perlin *Perlin) Noise(p vec3.Vec3) float64 {
+	fx, fy, fz := math.Floor(p.X), math.Floor(p.Y), math.Floor(p.Z)
+	xi, yi, zi := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z := p.X-fx, p.Y-fy, p.Z-fz
+	u, v, w := fade(x), fade(y), fade(z)
+
+	perm := &perlin.permutation
+	a := perm[xi] + yi
+	aa := perm[a] + zi
+	ab := perm[a+1] + zi
+	b := perm[xi+1] + yi
+	ba := perm[b] + zi
+	bb := perm[b+1] + zi
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, gradient(perm[aa], x, y, z), gradient(perm[ba], x-1, y, z)),
+			lerp(u, gradient(perm[ab], x, y-1, z), gradient(perm[bb], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, gradient(perm[aa+1], x, y, z-1), gradient(perm[ba+1], x-1, y, z-1)),
+			lerp(u, gradient(perm[ab+1], x, y-1, z-1), gradient(perm[bb+1], x-1, y-1, z-1))))
+}
+
+// FBM sums octaves of noise, each at twice the frequency and half the amplitude of the last.
+// The result stays in about [-1, 1].
+// https://thebookofshaders.com/13/
+func (perlin *Perlin) FBM(p vec3.Vec3, octaves int) float64 {
+	sum, amplitude, total := 0.0, 1.0, 0.0
+	for i := 0; i < octaves; i++ {
+		sum += amplitude * perlin.Noise(p)
+		total += amplitude
+		amplitude *= .5
+		p = vec3.MultiplyScalar(p, 2)
+	}
+	if total == 0 {
+		return 0
+	}
+	return sum / total
+}
+
+// Turbulence is FBM of the absolute noise, which creases the zero crossings. It is in about [0, 1].
+func (perlin *Perlin) Turbulence(p vec3.Vec3, octaves int) float64 {
+	sum, amplitude, total := 0.0, 1.0, 0.0
+	for i := 0; i < octaves; i++ {
+		sum += amplitude * math.Abs(perlin.Noise(p))
+		total += amplitude
+		amplitude *= .5
+		p = vec3.MultiplyScalar(p, 2)
+	}
+	if total == 0 {
+		return 0
+	}
+	return sum / total
+}
+
+// noise textures without a Perlin share this one, so their zero values are usable
+var defaultPerlin = NewPerlin(rand.New(rand.NewSource(0)))
+
+func perlinOrDefault(perlin *Perlin) *Perlin {
+	if perlin == nil {
+		return defaultPerlin
+	}
+	return perlin
+}
+
+// noise textures read a zero Scale as 1, like Checker's Size
+func scaleOrOne(scale float64) float64 {
+	if scale == 0 {
+		return 1
+	}
+	return scale
+}
+
+func octaves(octaves int) int {
+	if octaves <= 0 {
+		return DefaultOctaves
+	}
+	return octaves
+}
+
+// Noise shades between Low and High with fBm noise of the hit point, Scale times per unit.
+// A nil Perlin uses a fixed default one, and zero Scale and Octaves use 1 and DefaultOctaves.
+// The same goes for Turbulence and Marble.
+type Noise struct {
+	Perlin    *Perlin
+	Scale     float64
+	Octaves   int
+	Low, High vec3.Vec3
+}
+
+func (texture Noise) Value(u float64, v float64, p vec3.Vec3) vec3.Vec3 {
+	n := perlinOrDefault(texture.Perlin).FBM(vec3.MultiplyScalar(p, scaleOrOne(texture.Scale)), octaves(texture.Octaves))
+	return vec3.Lerp(texture.Low, texture.High, .5*(1+n))
+}
+
+// Turbulence shades between Low and High with turbulence of the hit point.
+type Turbulence struct {
+	Perlin    *Perlin
+	Scale     float64
+	Octaves   int
+	Low, High vec3.Vec3
+}
+
+func (texture Turbulence) Value(u float64, v float64, p vec3.Vec3) vec3.Vec3 {
+	n := perlinOrDefault(texture.Perlin).Turbulence(vec3.MultiplyScalar(p, scaleOrOne(texture.Scale)), octaves(texture.Octaves))
+	return vec3.Lerp(texture.Low, texture.High, math.Min(n, 1))
+}
+
+// Marble is stripes along Z, Scale per unit, distorted by turbulence of strength Distortion.
+type Marble struct {
+	Perlin     *Perlin
+	Scale      float64
+	Distortion float64
+	Octaves    int
+	Vein, Base vec3.Vec3
+}
+
+func (texture Marble) Value(u float64, v float64, p vec3.Vec3) vec3.Vec3 {
+	scale := scaleOrOne(texture.Scale)
+	turbulence := perlinOrDefault(texture.Perlin).Turbulence(vec3.MultiplyScalar(p, scale), octaves(texture.Octaves))
+	stripe := .5 * (1 + math.Sin(scale*p.Z+texture.Distortion*turbulence))
+	return vec3.Lerp(texture.Vein, texture.Base, stripe)
+}
@@ -0,0 +1,132 @@
+package texture_test
+
+import (
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func randomPoint(r *rand.Rand) vec3.Vec3 {
+	return vec3.MultiplyScalar(vec3.Vec3{X: r.Float64()*2 - 1, Y: r.Float64()*2 - 1, Z: r.Float64()*2 - 1}, 100)
+}
+
+func TestPerlin_ZeroAtLatticePoints(t *testing.T) {
+	perlin := texture.NewPerlin(rand.New(rand.NewSource(0)))
+
+	for x := -5; x <= 5; x++ {
+		for y := -5; y <= 5; y++ {
+			p := vec3.Vec3{X: float64(x), Y: float64(y), Z: float64(x * y)}
+			if n := perlin.Noise(p); n != 0 {
+				t.Fatal(p, n)
+			}
+		}
+	}
+}
+
+func TestPerlin_BoundedAndVaried(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	perlin := texture.NewPerlin(r)
+
+	min, max := math.Inf(1), math.Inf(-1)
+	for i := 0; i < 100000; i++ {
+		p := randomPoint(r)
+		n := perlin.Noise(p)
+		fbm := perlin.FBM(p, texture.DefaultOctaves)
+		turbulence := perlin.Turbulence(p, texture.DefaultOctaves)
+
+		if math.Abs(n) > 1.1 || math.Abs(fbm) > 1.1 || turbulence < 0 || turbulence > 1.1 {
+			t.Fatal(p, n, fbm, turbulence)
+		}
+		min = math.Min(min, n)
+		max = math.Max(max, n)
+	}
+
+	if min > -.5 || max < .5 {
+		t.Error("noise is too flat", min, max)
+	}
+}
+
+func TestPerlin_Continuous(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	perlin := texture.NewPerlin(r)
+
+	for i := 0; i < 10000; i++ {
+		p := randomPoint(r)
+		q := vec3.Add(p, vec3.MultiplyScalar(vec3.RandomInUnitSphere(r), .0001))
+		if math.Abs(perlin.Noise(p)-perlin.Noise(q)) > .001 {
+			t.Fatal(p, q)
+		}
+	}
+}
+
+func TestPerlin_SeedsDiffer(t *testing.T) {
+	a := texture.NewPerlin(rand.New(rand.NewSource(1)))
+	b := texture.NewPerlin(rand.New(rand.NewSource(2)))
+	p := vec3.Vec3{X: .5, Y: .25, Z: .75}
+
+	if a.Noise(p) == b.Noise(p) {
+		t.Error("two seeds give the same noise")
+	}
+}
+
+// noise overshoots its nominal range a little
+func between(x float64, low float64, high float64) bool {
+	return x >= low-.05 && x <= high+.05
+}
+
+func TestNoiseTexturesStayBetweenTheirColors(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	perlin := texture.NewPerlin(r)
+	low := vec3.Vec3{X: .1, Y: .2, Z: .3}
+	high := vec3.Vec3{X: .9, Y: .8, Z: .7}
+
+	textures := []texture.Texture{
+		texture.Noise{Perlin: perlin, Scale: 4, Low: low, High: high},
+		texture.Turbulence{Perlin: perlin, Scale: 4, Low: low, High: high},
+		texture.Marble{Perlin: perlin, Scale: 4, Distortion: 10, Vein: low, Base: high},
+	}
+
+	for _, tex := range textures {
+		for i := 0; i < 10000; i++ {
+			c := tex.Value(0, 0, randomPoint(r))
+			if !between(c.X, low.X, high.X) || !between(c.Y, low.Y, high.Y) || !between(c.Z, low.Z, high.Z) {
+				t.Fatalf("%T: %v", tex, c)
+			}
+		}
+	}
+}
+
+// without a Perlin or Scale the textures use defaults rather than panicking
+func TestNoiseTexturesZeroValues(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	low := vec3.Vec3{X: .1, Y: .2, Z: .3}
+	high := vec3.Vec3{X: .9, Y: .8, Z: .7}
+
+	textures := []texture.Texture{
+		texture.Noise{Low: low, High: high},
+		texture.Turbulence{Low: low, High: high},
+		texture.Marble{Vein: low, Base: high},
+	}
+
+	for _, tex := range textures {
+		varied := false
+		first := tex.Value(0, 0, randomPoint(r))
+		for i := 0; i < 1000; i++ {
+			c := tex.Value(0, 0, randomPoint(r))
+			if !between(c.X, low.X, high.X) || !between(c.Y, low.Y, high.Y) || !between(c.Z, low.Z, high.Z) {
+				t.Fatalf("%T: %v", tex, c)
+			}
+			varied = varied || c != first
+		}
+		if !varied {
+			t.Errorf("%T is constant", tex)
+		}
+	}
+
+	var zero texture.Noise
+	if got := zero.Value(0, 0, vec3.Vec3{X: .5}); got != (vec3.Vec3{}) {
+		t.Errorf("the zero Noise = %v, want black", got)
+	}
+}
@@ -0,0 +1,53 @@
+package texture
+
+import (
+	"goraytracer/vec3"
+	"math"
+)
+
+// A Texture is a color that varies over a surface. Image textures look it up by the hit's
+// UV coordinates, procedural ones compute it from the hit point.
+type Texture interface {
+	Value(u float64, v float64, p vec3.Vec3) vec3.Vec3
+}
+
+func (texture *Image) Value(u float64, v float64, p vec3.Vec3) vec3.Vec3 {
+	return texture.Sample(u, v)
+}
+
+// Solid is the same color everywhere.
+type Solid struct {
+	Color vec3.Vec3
+}
+
+func (texture Solid) Value(u float64, v float64, p vec3.Vec3) vec3.Vec3 {
+	return texture.Color
+}
+
+// Checker alternates between two textures in cubes of Size world units.
+// Being three dimensional, it doesn't depend on how a surface is parameterized.
+// A nil Even is white and a nil Odd black, a Size of zero or less is 1.
+type Checker struct {
+	Even Texture
+	Odd  Texture
+	Size float64
+}
+
+func (texture Checker) Value(u float64, v float64, p vec3.Vec3) vec3.Vec3 {
+	size := texture.Size
+	if size <= 0 {
+		size = 1
+	}
+
+	cell := int(math.Floor(p.X/size)) + int(math.Floor(p.Y/size)) + int(math.Floor(p.Z/size))
+	if cell%2 == 0 {
+		if texture.Even == nil {
+			return vec3.Vec3{X: 1, Y: 1, Z: 1}
+		}
+		return texture.Even.Value(u, v, p)
+	}
+	if texture.Odd == nil {
+		return vec3.Vec3{}
+	}
+	return texture.Odd.Value(u, v, p)
+}
@@ -0,0 +1,54 @@
+package texture_test
+
+import (
+	"goraytracer/texture"
+	"goraytracer/vec3"
+	"testing"
+)
+
+func TestChecker(t *testing.T) {
+	checker := texture.Checker{
+		Even: texture.Solid{Color: red},
+		Odd:  texture.Solid{Color: blue},
+		Size: 2,
+	}
+
+	tests := []struct {
+		name string
+		p    vec3.Vec3
+		want vec3.Vec3
+	}{
+		{name: "origin cell", p: vec3.Vec3{X: .5, Y: .5, Z: .5}, want: red},
+		{name: "next cell along x", p: vec3.Vec3{X: 2.5, Y: .5, Z: .5}, want: blue},
+		{name: "diagonal cell", p: vec3.Vec3{X: 2.5, Y: 2.5, Z: .5}, want: red},
+		{name: "negative cell", p: vec3.Vec3{X: -.5, Y: .5, Z: .5}, want: blue},
+		{name: "three steps", p: vec3.Vec3{X: -.5, Y: -.5, Z: -.5}, want: blue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the checker ignores uv
+			if got := checker.Value(.3, .7, tt.p); got != tt.want {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecker_ZeroValue(t *testing.T) {
+	var checker texture.Checker
+
+	if got := checker.Value(0, 0, vec3.Vec3{X: .5, Y: .5, Z: .5}); got != (vec3.Vec3{X: 1, Y: 1, Z: 1}) {
+		t.Errorf("even cell = %v, want white", got)
+	}
+	if got := checker.Value(0, 0, vec3.Vec3{X: 1.5, Y: .5, Z: .5}); got != (vec3.Vec3{}) {
+		t.Errorf("odd cell = %v, want black", got)
+	}
+}
+
+func TestImageIsATexture(t *testing.T) {
+	var tex texture.Texture = texture.NewImage(checkerImage(), texture.Linear)
+
+	if got := tex.Value(.75, .75, vec3.Vec3{X: 100}); !nearVec3(got, red) {
+		t.Error(got)
+	}
+}
@@ -0,0 +1,113 @@
+package transform
+
+import (
+	"goraytracer/vec3"
+	"math"
+)
+
+// Quaternion is a rotation W + X*i + Y*j + Z*k. Only unit quaternions represent rotations.
+type Quaternion struct {
+	X, Y, Z, W float64
+}
+
+func IdentityQuaternion() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// AxisAngle returns the rotation by angle radians around axis.
+func AxisAngle(axis vec3.Vec3, angle float64) Quaternion {
+	a := vec3.MultiplyScalar(axis.Normalized(), math.Sin(angle/2.0))
+	return Quaternion{X: a.X, Y: a.Y, Z: a.Z, W: math.Cos(angle / 2.0)}
+}
+
+// MultiplyQuaternions returns a * b, the rotation that applies b first and then a.
+func MultiplyQuaternions(a Quaternion, b Quaternion) Quaternion {
+	return Quaternion{
+		X: a.W*b.X + a.X*b.W + a.Y*b.Z - a.Z*b.Y,
+		Y: a.W*b.Y - a.X*b.Z + a.Y*b.W + a.Z*b.X,
+		Z: a.W*b.Z + a.X*b.Y - a.Y*b.X + a.Z*b.W,
+		W: a.W*b.W - a.X*b.X - a.Y*b.Y - a.Z*b.Z,
+	}
+}
+
+func dotQuaternions(a Quaternion, b Quaternion) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W*b.W
+}
+
+func (q Quaternion) Length() float64 {
+	return math.Sqrt(dotQuaternions(q, q))
+}
+
+func (q Quaternion) Normalized() Quaternion {
+	length := q.Length()
+	if length == 0 {
+		return IdentityQuaternion()
+	}
+	return Quaternion{X: q.X / length, Y: q.Y / length, Z: q.Z / length, W: q.W / length}
+}
+
+// Conjugate is the inverse rotation of a unit quaternion.
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{X: -q.X, Y: -q.Y, Z: -q.Z, W: q.W}
+}
+
+// Rotate applies the rotation of a unit quaternion to v.
+func (q Quaternion) Rotate(v vec3.Vec3) vec3.Vec3 {
+	// v' = v + 2w(q x v) + 2(q x (q x v))
+	axis := vec3.Vec3{X: q.X, Y: q.Y, Z: q.Z}
+	t := vec3.MultiplyScalar(vec3.Cross(axis, v), 2.0)
+	return vec3.Add(vec3.Add(v, vec3.MultiplyScalar(t, q.W)), vec3.Cross(axis, t))
+}
+
+// Matrix returns the rotation matrix of a unit quaternion.
+func (q Quaternion) Matrix() Matrix {
+	x, y, z, w := q.X, q.Y, q.Z, q.W
+
+	return Matrix{
+		{1 - 2*(y*y+z*z), 2 * (x*y - z*w), 2 * (x*z + y*w), 0},
+		{2 * (x*y + z*w), 1 - 2*(x*x+z*z), 2 * (y*z - x*w), 0},
+		{2 * (x*z - y*w), 2 * (y*z + x*w), 1 - 2*(x*x+y*y), 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Slerp interpolates between two rotations at constant angular velocity, t in [0, 1].
+// It always takes the shorter way around.
+// https://en.wikipedia.org/wiki/Slerp
+func Slerp(a Quaternion, b Quaternion, t float64) Quaternion {
+	cos := dotQuaternions(a, b)
+
+	// q and -q are the same rotation, flip to interpolate along the shorter arc
+	if cos < 0 {
+		b = Quaternion{X: -b.X, Y: -b.Y, Z: -b.Z, W: -b.W}
+		cos = -cos
+	}
+
+	// nearly parallel, fall back to a normalized lerp to avoid dividing by sin ~ 0
+	if cos > 0.9995 {
+		return Quaternion{
+			X: a.X + (b.X-a.X)*t,
+			Y: a.Y + (b.Y-a.Y)*t,
+			Z: a.Z + (b.Z-a.Z)*t,
+			W: a.W + (b.W-a.W)*t,
+		}.Normalized()
+	}
+
+	theta := math.Acos(cos)
+	sin := math.Sin(theta)
+	wa := math.Sin((1-t)*theta) / sin
+	wb := math.Sin(t*theta) / sin
+
+	return Quaternion{
+		X: wa*a.X + wb*b.X,
+		Y: wa*a.Y + wb*b.Y,
+		Z: wa*a.Z + wb*b.Z,
+		W: wa*a.W + wb*b.W,
+	}
+}
+
+// TRS composes a transform that scales, then rotates, then translates,
+// the order used by most scene formats.
+func TRS(translation vec3.Vec3, rotation Quaternion, scale vec3.Vec3) Matrix {
+	return Compose(Scale(scale), rotation.Matrix(), Translate(translation))
+}
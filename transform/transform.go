@@ -0,0 +1,241 @@
+package transform
+
+import (
+	"goraytracer/vec3"
+	"math"
+)
+
+// Matrix is a 4x4 matrix in row major order, acting on column vectors.
+// Affine transforms keep their translation in the last column.
+type Matrix [4][4]float64
+
+func Identity() Matrix {
+	return Matrix{
+		{1, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+func Translate(offset vec3.Vec3) Matrix {
+	return Matrix{
+		{1, 0, 0, offset.X},
+		{0, 1, 0, offset.Y},
+		{0, 0, 1, offset.Z},
+		{0, 0, 0, 1},
+	}
+}
+
+func Scale(factor vec3.Vec3) Matrix {
+	return Matrix{
+		{factor.X, 0, 0, 0},
+		{0, factor.Y, 0, 0},
+		{0, 0, factor.Z, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Rotate returns a counter clockwise rotation by angle radians around axis.
+// https://en.wikipedia.org/wiki/Rotation_matrix#Rotation_matrix_from_axis_and_angle
+func Rotate(axis vec3.Vec3, angle float64) Matrix {
+	a := axis.Normalized()
+	sin := math.Sin(angle)
+	cos := math.Cos(angle)
+	t := 1.0 - cos
+
+	return Matrix{
+		{cos + a.X*a.X*t, a.X*a.Y*t - a.Z*sin, a.X*a.Z*t + a.Y*sin, 0},
+		{a.Y*a.X*t + a.Z*sin, cos + a.Y*a.Y*t, a.Y*a.Z*t - a.X*sin, 0},
+		{a.Z*a.X*t - a.Y*sin, a.Z*a.Y*t + a.X*sin, cos + a.Z*a.Z*t, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+func RotateX(angle float64) Matrix {
+	return Rotate(vec3.Vec3{X: 1}, angle)
+}
+
+func RotateY(angle float64) Matrix {
+	return Rotate(vec3.Vec3{Y: 1}, angle)
+}
+
+func RotateZ(angle float64) Matrix {
+	return Rotate(vec3.Vec3{Z: 1}, angle)
+}
+
+// Multiply returns a * b, the transform that applies b first and then a.
+func Multiply(a Matrix, b Matrix) Matrix {
+	var m Matrix
+	for row := 0; row < 4; row++ {
+		for column := 0; column < 4; column++ {
+			for i := 0; i < 4; i++ {
+				m[row][column] += a[row][i] * b[i][column]
+			}
+		}
+	}
+	return m
+}
+
+// Compose chains transforms in the order they are applied, so
+// Compose(Scale(s), Translate(t)) scales first and then translates.
+func Compose(transforms ...Matrix) Matrix {
+	m := Identity()
+	for _, t := range transforms {
+		m = Multiply(t, m)
+	}
+	return m
+}
+
+func (m Matrix) Transpose() Matrix {
+	var t Matrix
+	for row := 0; row < 4; row++ {
+		for column := 0; column < 4; column++ {
+			t[row][column] = m[column][row]
+		}
+	}
+	return t
+}
+
+// Inverse inverts any non singular matrix, including projections.
+// It reports false when m is singular.
+func (m Matrix) Inverse() (Matrix, bool) {
+	// Gauss-Jordan elimination with partial pivoting on [m | I]
+	a := m
+	inverse := Identity()
+
+	for column := 0; column < 4; column++ {
+		pivot := column
+		for row := column + 1; row < 4; row++ {
+			if math.Abs(a[row][column]) > math.Abs(a[pivot][column]) {
+				pivot = row
+			}
+		}
+
+		if a[pivot][column] == 0 {
+			return Matrix{}, false
+		}
+
+		a[column], a[pivot] = a[pivot], a[column]
+		inverse[column], inverse[pivot] = inverse[pivot], inverse[column]
+
+		scale := 1.0 / a[column][column]
+		for i := 0; i < 4; i++ {
+			a[column][i] *= scale
+			inverse[column][i] *= scale
+		}
+
+		for row := 0; row < 4; row++ {
+			if row == column {
+				continue
+			}
+			factor := a[row][column]
+			for i := 0; i < 4; i++ {
+				a[row][i] -= factor * a[column][i]
+				inverse[row][i] -= factor * inverse[column][i]
+			}
+		}
+	}
+
+	return inverse, true
+}
+
+// InverseAffine inverts a matrix whose last row is 0 0 0 1.
+// It reports false when the linear part is singular, for example after a zero scale.
+func (m Matrix) InverseAffine() (Matrix, bool) {
+	// inverse of the upper 3x3 via cofactors
+	c00 := m[1][1]*m[2][2] - m[1][2]*m[2][1]
+	c01 := m[1][2]*m[2][0] - m[1][0]*m[2][2]
+	c02 := m[1][0]*m[2][1] - m[1][1]*m[2][0]
+
+	determinant := m[0][0]*c00 + m[0][1]*c01 + m[0][2]*c02
+	if determinant == 0 {
+		return Matrix{}, false
+	}
+
+	d := 1.0 / determinant
+	var inverse Matrix
+	inverse[0][0] = c00 * d
+	inverse[0][1] = (m[0][2]*m[2][1] - m[0][1]*m[2][2]) * d
+	inverse[0][2] = (m[0][1]*m[1][2] - m[0][2]*m[1][1]) * d
+	inverse[1][0] = c01 * d
+	inverse[1][1] = (m[0][0]*m[2][2] - m[0][2]*m[2][0]) * d
+	inverse[1][2] = (m[0][2]*m[1][0] - m[0][0]*m[1][2]) * d
+	inverse[2][0] = c02 * d
+	inverse[2][1] = (m[0][1]*m[2][0] - m[0][0]*m[2][1]) * d
+	inverse[2][2] = (m[0][0]*m[1][1] - m[0][1]*m[1][0]) * d
+
+	// the inverse translation is the original one, inverse rotated and negated
+	translation := vec3.Vec3{X: m[0][3], Y: m[1][3], Z: m[2][3]}
+	t := inverse.TransformVector(translation)
+	inverse[0][3] = -t.X
+	inverse[1][3] = -t.Y
+	inverse[2][3] = -t.Z
+	inverse[3][3] = 1
+
+	return inverse, true
+}
+
+// TransformPoint applies the full transform, including translation.
+func (m Matrix) TransformPoint(p vec3.Vec3) vec3.Vec3 {
+	return vec3.Vec3{
+		X: m[0][0]*p.X + m[0][1]*p.Y + m[0][2]*p.Z + m[0][3],
+		Y: m[1][0]*p.X + m[1][1]*p.Y + m[1][2]*p.Z + m[1][3],
+		Z: m[2][0]*p.X + m[2][1]*p.Y + m[2][2]*p.Z + m[2][3],
+	}
+}
+
+// ProjectPoint applies the full transform and divides by w, as needed for projections.
+func (m Matrix) ProjectPoint(p vec3.Vec3) vec3.Vec3 {
+	w := m[3][0]*p.X + m[3][1]*p.Y + m[3][2]*p.Z + m[3][3]
+	return vec3.MultiplyScalar(m.TransformPoint(p), 1.0/w)
+}
+
+// TransformVector applies the linear part only, directions are not translated.
+func (m Matrix) TransformVector(v vec3.Vec3) vec3.Vec3 {
+	return vec3.Vec3{
+		X: m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z,
+		Y: m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z,
+		Z: m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+// TransformNormal multiplies n by the transpose of m's linear part.
+// Normals have to be transformed by the inverse transpose, so call this on the inverse:
+// worldNormal := worldToObject.TransformNormal(objectNormal). The result is not normalized.
+func (m Matrix) TransformNormal(n vec3.Vec3) vec3.Vec3 {
+	return vec3.Vec3{
+		X: m[0][0]*n.X + m[1][0]*n.Y + m[2][0]*n.Z,
+		Y: m[0][1]*n.X + m[1][1]*n.Y + m[2][1]*n.Z,
+		Z: m[0][2]*n.X + m[1][2]*n.Y + m[2][2]*n.Z,
+	}
+}
+
+// LookAt returns the view matrix of a camera at eye looking at target, the transform
+// from world space into a camera space where the camera looks down -Z with +Y up.
+func LookAt(eye vec3.Vec3, target vec3.Vec3, up vec3.Vec3) Matrix {
+	w := vec3.Sub(eye, target).Normalized()
+	u := vec3.Cross(up, w).Normalized()
+	v := vec3.Cross(w, u)
+
+	return Matrix{
+		{u.X, u.Y, u.Z, -vec3.Dot(u, eye)},
+		{v.X, v.Y, v.Z, -vec3.Dot(v, eye)},
+		{w.X, w.Y, w.Z, -vec3.Dot(w, eye)},
+		{0, 0, 0, 1},
+	}
+}
+
+// Perspective returns a projection from camera space (looking down -Z) to clip space,
+// mapping the view frustum to -1..1 on every axis after the divide by w.
+// verticalFov is in radians.
+func Perspective(verticalFov float64, aspectRatio float64, near float64, far float64) Matrix {
+	f := 1.0 / math.Tan(verticalFov/2.0)
+
+	return Matrix{
+		{f / aspectRatio, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, (far + near) / (near - far), 2 * far * near / (near - far)},
+		{0, 0, -1, 0},
+	}
+}
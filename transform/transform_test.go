@@ -0,0 +1,299 @@
+package transform_test
+
+import (
+	"goraytracer/transform"
+	"goraytracer/vec3"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+const epsilon = .000001
+
+func near(a float64, b float64) bool {
+	return math.Abs(a-b) < epsilon
+}
+
+func nearVec3(a vec3.Vec3, b vec3.Vec3) bool {
+	return near(a.X, b.X) && near(a.Y, b.Y) && near(a.Z, b.Z)
+}
+
+func nearMatrix(a transform.Matrix, b transform.Matrix) bool {
+	for row := 0; row < 4; row++ {
+		for column := 0; column < 4; column++ {
+			if !near(a[row][column], b[row][column]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func randomVec3(r *rand.Rand) vec3.Vec3 {
+	return vec3.Vec3{X: r.Float64()*2 - 1, Y: r.Float64()*2 - 1, Z: r.Float64()*2 - 1}
+}
+
+func randomRotation(r *rand.Rand) transform.Quaternion {
+	return transform.AxisAngle(vec3.RandomInUnitSphere(r), (r.Float64()*2-1)*math.Pi)
+}
+
+func randomAffine(r *rand.Rand) transform.Matrix {
+	scale := vec3.Vec3{X: .5 + r.Float64(), Y: .5 + r.Float64(), Z: .5 + r.Float64()}
+	return transform.TRS(vec3.MultiplyScalar(randomVec3(r), 10), randomRotation(r), scale)
+}
+
+func TestInverseAffineTimesMatrixIsIdentity(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		m := randomAffine(r)
+		inverse, ok := m.InverseAffine()
+
+		if !ok || !nearMatrix(transform.Multiply(m, inverse), transform.Identity()) ||
+			!nearMatrix(transform.Multiply(inverse, m), transform.Identity()) {
+			t.Fatal(i, m)
+		}
+	}
+}
+
+func TestInverseAffineOfZeroScaleFails(t *testing.T) {
+	singular := transform.Scale(vec3.Vec3{X: 1, Y: 0, Z: 1})
+
+	if _, ok := singular.InverseAffine(); ok {
+		t.Error("inverted a zero scale")
+	}
+}
+
+func TestComposeAppliesInOrder(t *testing.T) {
+	scale := transform.Scale(vec3.Vec3{X: 2, Y: 2, Z: 2})
+	translate := transform.Translate(vec3.Vec3{X: 1})
+	p := vec3.Vec3{X: 1, Y: 1, Z: 1}
+
+	if got := transform.Compose(scale, translate).TransformPoint(p); got != (vec3.Vec3{X: 3, Y: 2, Z: 2}) {
+		t.Error("scale then translate", got)
+	}
+	if got := transform.Compose(translate, scale).TransformPoint(p); got != (vec3.Vec3{X: 4, Y: 2, Z: 2}) {
+		t.Error("translate then scale", got)
+	}
+}
+
+func TestRotateIsCounterClockwise(t *testing.T) {
+	quarter := math.Pi / 2
+
+	if p := transform.RotateX(quarter).TransformVector(vec3.Vec3{Y: 1}); !nearVec3(p, vec3.Vec3{Z: 1}) {
+		t.Error("x", p)
+	}
+	if p := transform.RotateY(quarter).TransformVector(vec3.Vec3{Z: 1}); !nearVec3(p, vec3.Vec3{X: 1}) {
+		t.Error("y", p)
+	}
+	if p := transform.RotateZ(quarter).TransformVector(vec3.Vec3{X: 1}); !nearVec3(p, vec3.Vec3{Y: 1}) {
+		t.Error("z", p)
+	}
+}
+
+func TestTransformedNormalStaysPerpendicular(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		m := randomAffine(r)
+		inverse, _ := m.InverseAffine()
+
+		normal := randomVec3(r)
+		tangent := vec3.Cross(normal, randomVec3(r))
+
+		if !near(vec3.Dot(inverse.TransformNormal(normal), m.TransformVector(tangent)), 0) {
+			t.Fatal(i, m)
+		}
+	}
+}
+
+func TestVectorsIgnoreTranslation(t *testing.T) {
+	translate := transform.Translate(vec3.Vec3{X: 1, Y: 2, Z: 3})
+	v := vec3.Vec3{X: 4, Y: 5, Z: 6}
+
+	if translate.TransformVector(v) != v {
+		t.Error(translate.TransformVector(v))
+	}
+	if translate.TransformPoint(v) != (vec3.Vec3{X: 5, Y: 7, Z: 9}) {
+		t.Error(translate.TransformPoint(v))
+	}
+}
+
+func TestInverseTimesMatrixIsIdentity(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		var m transform.Matrix
+		for row := 0; row < 4; row++ {
+			for column := 0; column < 4; column++ {
+				m[row][column] = r.Float64()*2 - 1
+			}
+		}
+
+		inverse, ok := m.Inverse()
+		if !ok {
+			continue
+		}
+
+		if !nearMatrix(transform.Multiply(m, inverse), transform.Identity()) ||
+			!nearMatrix(transform.Multiply(inverse, m), transform.Identity()) {
+			t.Fatal(i, m)
+		}
+	}
+}
+
+func TestInverseOfSingularMatrixFails(t *testing.T) {
+	singular := transform.Scale(vec3.Vec3{X: 1, Y: 0, Z: 1})
+
+	if _, ok := singular.Inverse(); ok {
+		t.Error("inverted a zero scale")
+	}
+}
+
+func TestInverseAffineMatchesInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		m := randomAffine(r)
+		general, _ := m.Inverse()
+		affine, ok := m.InverseAffine()
+
+		if !ok || !nearMatrix(general, affine) {
+			t.Fatal(i, m)
+		}
+	}
+}
+
+func TestTransposeTwiceIsIdentity(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		m := randomAffine(r)
+		if m.Transpose().Transpose() != m {
+			t.Fatal(i, m)
+		}
+		if m.Transpose()[0][3] != m[3][0] {
+			t.Fatal(i, m)
+		}
+	}
+}
+
+func TestQuaternionMatchesRotate(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		axis := vec3.RandomInUnitSphere(r)
+		angle := (r.Float64()*2 - 1) * math.Pi
+		q := transform.AxisAngle(axis, angle)
+		v := randomVec3(r)
+
+		expected := transform.Rotate(axis, angle)
+		if !nearMatrix(q.Matrix(), expected) {
+			t.Fatal(i, q)
+		}
+		if !nearVec3(q.Rotate(v), expected.TransformVector(v)) {
+			t.Fatal(i, q, v)
+		}
+	}
+}
+
+func TestQuaternionRotationPreservesLength(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		q := randomRotation(r)
+		v := randomVec3(r)
+
+		if !near(q.Length(), 1) || !near(q.Rotate(v).Length(), v.Length()) {
+			t.Fatal(i, q, v)
+		}
+	}
+}
+
+func TestMultiplyQuaternionsComposesRotations(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		a := randomRotation(r)
+		b := randomRotation(r)
+		v := randomVec3(r)
+
+		if !nearVec3(transform.MultiplyQuaternions(a, b).Rotate(v), a.Rotate(b.Rotate(v))) {
+			t.Fatal(i, a, b)
+		}
+		if !nearVec3(a.Conjugate().Rotate(a.Rotate(v)), v) {
+			t.Fatal(i, a)
+		}
+	}
+}
+
+func TestSlerpEndpointsAndLength(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		a := randomRotation(r)
+		b := randomRotation(r)
+		v := randomVec3(r)
+
+		// q and -q are the same rotation, so compare the rotated vectors
+		if !nearVec3(transform.Slerp(a, b, 0).Rotate(v), a.Rotate(v)) ||
+			!nearVec3(transform.Slerp(a, b, 1).Rotate(v), b.Rotate(v)) {
+			t.Fatal(i, a, b)
+		}
+
+		if !near(transform.Slerp(a, b, r.Float64()).Length(), 1) {
+			t.Fatal(i, a, b)
+		}
+	}
+}
+
+func TestSlerpHalfwayIsHalfTheAngle(t *testing.T) {
+	a := transform.IdentityQuaternion()
+	b := transform.AxisAngle(vec3.Vec3{Y: 1}, math.Pi/2)
+
+	halfway := transform.Slerp(a, b, .5)
+	expected := transform.AxisAngle(vec3.Vec3{Y: 1}, math.Pi/4)
+
+	if !nearMatrix(halfway.Matrix(), expected.Matrix()) {
+		t.Error(halfway)
+	}
+}
+
+func TestLookAt(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		eye := vec3.MultiplyScalar(randomVec3(r), 10)
+		target := randomVec3(r)
+		view := transform.LookAt(eye, target, vec3.Vec3{Y: 1})
+
+		distance := vec3.Sub(target, eye).Length()
+		if !nearVec3(view.TransformPoint(eye), vec3.Vec3{}) ||
+			!nearVec3(view.TransformPoint(target), vec3.Vec3{Z: -distance}) {
+			t.Fatal(i, eye, target)
+		}
+	}
+}
+
+func TestPerspectiveMapsFrustumToClipSpace(t *testing.T) {
+	const near, far = .1, 100.0
+	projection := transform.Perspective(math.Pi/2, 2, near, far)
+
+	if p := projection.ProjectPoint(vec3.Vec3{Z: -near}); !nearVec3(p, vec3.Vec3{Z: -1}) {
+		t.Error("near plane", p)
+	}
+	if p := projection.ProjectPoint(vec3.Vec3{Z: -far}); !nearVec3(p, vec3.Vec3{Z: 1}) {
+		t.Error("far plane", p)
+	}
+
+	// a 90 degree field of view puts the top edge of the frustum at y = -z
+	if p := projection.ProjectPoint(vec3.Vec3{X: 2, Y: 1, Z: -1}); !nearVec3(p, vec3.Vec3{X: 1, Y: 1, Z: p.Z}) {
+		t.Error("corner", p)
+	}
+
+	inverse, ok := projection.Inverse()
+	p := vec3.Vec3{X: .3, Y: -.2, Z: -5}
+	if !ok || !nearVec3(inverse.ProjectPoint(projection.ProjectPoint(p)), p) {
+		t.Error("round trip", p)
+	}
+}
@@ -95,3 +95,36 @@ func RandomInUnitSphere(r *rand.Rand) Vec3 {
 	var z = _r * cosPhi
 	return Vec3{x, y, z}
 }
+
+// component-wise minimum
+func Min(a Vec3, b Vec3) Vec3 {
+	return Vec3{
+		X: math.Min(a.X, b.X),
+		Y: math.Min(a.Y, b.Y),
+		Z: math.Min(a.Z, b.Z),
+	}
+}
+
+// component-wise maximum
+func Max(a Vec3, b Vec3) Vec3 {
+	return Vec3{
+		X: math.Max(a.X, b.X),
+		Y: math.Max(a.Y, b.Y),
+		Z: math.Max(a.Z, b.Z),
+	}
+}
+
+// Reflect mirrors v about the plane with unit normal n.
+func Reflect(v Vec3, n Vec3) Vec3 {
+	return Sub(v, MultiplyScalar(n, 2*Dot(v, n)))
+}
+
+// Refract bends the unit vector v through a surface with unit normal n facing against it,
+// following Snell's law. etaRatio is the refractive index on v's side over the one on the far side.
+// The caller has to rule out total internal reflection first.
+func Refract(v Vec3, n Vec3, etaRatio float64) Vec3 {
+	cosTheta := math.Min(-Dot(v, n), 1.0)
+	perpendicular := MultiplyScalar(Add(v, MultiplyScalar(n, cosTheta)), etaRatio)
+	parallel := MultiplyScalar(n, -math.Sqrt(math.Abs(1.0-Dot(perpendicular, perpendicular))))
+	return Add(perpendicular, parallel)
+}
@@ -38,3 +38,48 @@ func TestRandomVectorInUnitSphereHasLength_LTE_One(t *testing.T) {
 		}
 	}
 }
+
+func TestReflectPreservesLengthAndFlipsNormalComponent(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		v := vec3.RandomInUnitSphere(r)
+		n := vec3.RandomInUnitSphere(r).Normalized()
+		reflected := vec3.Reflect(v, n)
+
+		if math.Abs(reflected.Length()-v.Length()) > .0000001 ||
+			math.Abs(vec3.Dot(reflected, n)+vec3.Dot(v, n)) > .0000001 {
+			t.Fatal(i, v, n)
+		}
+	}
+}
+
+func TestRefractWithEqualIndicesGoesStraightThrough(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 10000; i++ {
+		v := vec3.RandomInUnitSphere(r).Normalized()
+		n := vec3.RandomInUnitSphere(r).Normalized()
+		if vec3.Dot(v, n) > 0 {
+			n = vec3.MultiplyScalar(n, -1)
+		}
+
+		if vec3.Sub(vec3.Refract(v, n, 1), v).Length() > .0000001 {
+			t.Fatal(i, v, n)
+		}
+	}
+}
+
+func TestRefractFollowsSnellsLaw(t *testing.T) {
+	// from air into glass at 30 degrees, sin(refracted) = sin(30) / 1.5
+	incidence := math.Pi / 6
+	v := vec3.Vec3{X: math.Sin(incidence), Y: -math.Cos(incidence)}
+	n := vec3.Vec3{Y: 1}
+
+	refracted := vec3.Refract(v, n, 1/1.5)
+	expected := vec3.Vec3{X: 1 / 3.0, Y: -math.Sqrt(8) / 3}
+
+	if vec3.Sub(refracted, expected).Length() > .0000001 {
+		t.Error(refracted, expected)
+	}
+}